@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -512,19 +513,28 @@ func (x *ListMockAPIResponse) GetPagination() *ListResponse {
 	return nil
 }
 
-type MockAPI_Condition struct {
+// ProtoField describes a field of a loaded proto message
+type ProtoField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Condition:
-	//	*MockAPI_Condition_Simple
-	//	*MockAPI_Condition_Script
-	Condition isMockAPI_Condition_Condition `protobuf_oneof:"Condition"`
-}
-
-func (x *MockAPI_Condition) Reset() {
-	*x = MockAPI_Condition{}
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	JsonName string `protobuf:"bytes,2,opt,name=jsonName,proto3" json:"jsonName,omitempty"`
+	Number   int32  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	// type is the scalar type name, or "message" / "enum"
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// typeName is the fully qualified name of message and enum types
+	TypeName string `protobuf:"bytes,5,opt,name=typeName,proto3" json:"typeName,omitempty"`
+	// label is one of "optional", "required" and "repeated"
+	Label   string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	IsMap   bool   `protobuf:"varint,7,opt,name=isMap,proto3" json:"isMap,omitempty"`
+	Oneof   string `protobuf:"bytes,8,opt,name=oneof,proto3" json:"oneof,omitempty"`
+	Comment string `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *ProtoField) Reset() {
+	*x = ProtoField{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_apis_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,13 +542,13 @@ func (x *MockAPI_Condition) Reset() {
 	}
 }
 
-func (x *MockAPI_Condition) String() string {
+func (x *ProtoField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Condition) ProtoMessage() {}
+func (*ProtoField) ProtoMessage() {}
 
-func (x *MockAPI_Condition) ProtoReflect() protoreflect.Message {
+func (x *ProtoField) ProtoReflect() protoreflect.Message {
 	mi := &file_apis_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,61 +560,87 @@ func (x *MockAPI_Condition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Condition.ProtoReflect.Descriptor instead.
-func (*MockAPI_Condition) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ProtoField.ProtoReflect.Descriptor instead.
+func (*ProtoField) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *MockAPI_Condition) GetCondition() isMockAPI_Condition_Condition {
-	if m != nil {
-		return m.Condition
+func (x *ProtoField) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MockAPI_Condition) GetSimple() *MockAPI_Condition_SimpleCondition {
-	if x, ok := x.GetCondition().(*MockAPI_Condition_Simple); ok {
-		return x.Simple
+func (x *ProtoField) GetJsonName() string {
+	if x != nil {
+		return x.JsonName
 	}
-	return nil
+	return ""
 }
 
-func (x *MockAPI_Condition) GetScript() *MockAPI_Condition_ScriptCondition {
-	if x, ok := x.GetCondition().(*MockAPI_Condition_Script); ok {
-		return x.Script
+func (x *ProtoField) GetNumber() int32 {
+	if x != nil {
+		return x.Number
 	}
-	return nil
+	return 0
 }
 
-type isMockAPI_Condition_Condition interface {
-	isMockAPI_Condition_Condition()
+func (x *ProtoField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-type MockAPI_Condition_Simple struct {
-	Simple *MockAPI_Condition_SimpleCondition `protobuf:"bytes,1,opt,name=simple,proto3,oneof"`
+func (x *ProtoField) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
 }
 
-type MockAPI_Condition_Script struct {
-	Script *MockAPI_Condition_ScriptCondition `protobuf:"bytes,2,opt,name=script,proto3,oneof"`
+func (x *ProtoField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
 }
 
-func (*MockAPI_Condition_Simple) isMockAPI_Condition_Condition() {}
+func (x *ProtoField) GetIsMap() bool {
+	if x != nil {
+		return x.IsMap
+	}
+	return false
+}
 
-func (*MockAPI_Condition_Script) isMockAPI_Condition_Condition() {}
+func (x *ProtoField) GetOneof() string {
+	if x != nil {
+		return x.Oneof
+	}
+	return ""
+}
 
-type MockAPI_Response struct {
+func (x *ProtoField) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// ProtoMessage describes a loaded proto message
+type ProtoMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//	*MockAPI_Response_Simple
-	//	*MockAPI_Response_Script
-	Response isMockAPI_Response_Response `protobuf_oneof:"Response"`
+	Name    string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fields  []*ProtoField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Comment string        `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 }
 
-func (x *MockAPI_Response) Reset() {
-	*x = MockAPI_Response{}
+func (x *ProtoMessage) Reset() {
+	*x = ProtoMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_apis_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,13 +648,13 @@ func (x *MockAPI_Response) Reset() {
 	}
 }
 
-func (x *MockAPI_Response) String() string {
+func (x *ProtoMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Response) ProtoMessage() {}
+func (*ProtoMessage) ProtoMessage() {}
 
-func (x *MockAPI_Response) ProtoReflect() protoreflect.Message {
+func (x *ProtoMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_apis_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -630,59 +666,55 @@ func (x *MockAPI_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Response.ProtoReflect.Descriptor instead.
-func (*MockAPI_Response) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use ProtoMessage.ProtoReflect.Descriptor instead.
+func (*ProtoMessage) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{10}
 }
 
-func (m *MockAPI_Response) GetResponse() isMockAPI_Response_Response {
-	if m != nil {
-		return m.Response
+func (x *ProtoMessage) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MockAPI_Response) GetSimple() *MockAPI_Response_SimpleResponse {
-	if x, ok := x.GetResponse().(*MockAPI_Response_Simple); ok {
-		return x.Simple
+func (x *ProtoMessage) GetFields() []*ProtoField {
+	if x != nil {
+		return x.Fields
 	}
 	return nil
 }
 
-func (x *MockAPI_Response) GetScript() *MockAPI_Response_ScriptResponse {
-	if x, ok := x.GetResponse().(*MockAPI_Response_Script); ok {
-		return x.Script
+func (x *ProtoMessage) GetComment() string {
+	if x != nil {
+		return x.Comment
 	}
-	return nil
-}
-
-type isMockAPI_Response_Response interface {
-	isMockAPI_Response_Response()
-}
-
-type MockAPI_Response_Simple struct {
-	Simple *MockAPI_Response_SimpleResponse `protobuf:"bytes,1,opt,name=simple,proto3,oneof"`
-}
-
-type MockAPI_Response_Script struct {
-	Script *MockAPI_Response_ScriptResponse `protobuf:"bytes,2,opt,name=script,proto3,oneof"`
+	return ""
 }
 
-func (*MockAPI_Response_Simple) isMockAPI_Response_Response() {}
-
-func (*MockAPI_Response_Script) isMockAPI_Response_Response() {}
-
-type MockAPI_Case struct {
+// ProtoMethod describes a loaded gRPC method
+type ProtoMethod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Condition *MockAPI_Condition `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
-	Response  *MockAPI_Response  `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-}
-
-func (x *MockAPI_Case) Reset() {
-	*x = MockAPI_Case{}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// path is the gRPC path, such as /package.Service/Method
+	Path            string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Service         string        `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	ClientStreaming bool          `protobuf:"varint,4,opt,name=clientStreaming,proto3" json:"clientStreaming,omitempty"`
+	ServerStreaming bool          `protobuf:"varint,5,opt,name=serverStreaming,proto3" json:"serverStreaming,omitempty"`
+	InputType       *ProtoMessage `protobuf:"bytes,6,opt,name=inputType,proto3" json:"inputType,omitempty"`
+	OutputType      *ProtoMessage `protobuf:"bytes,7,opt,name=outputType,proto3" json:"outputType,omitempty"`
+	// dependencies contains all message types referenced by inputType and outputType
+	Dependencies []*ProtoMessage `protobuf:"bytes,8,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Comment      string          `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	File         string          `protobuf:"bytes,10,opt,name=file,proto3" json:"file,omitempty"`
+	Repository   string          `protobuf:"bytes,11,opt,name=repository,proto3" json:"repository,omitempty"`
+}
+
+func (x *ProtoMethod) Reset() {
+	*x = ProtoMethod{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_apis_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,13 +722,13 @@ func (x *MockAPI_Case) Reset() {
 	}
 }
 
-func (x *MockAPI_Case) String() string {
+func (x *ProtoMethod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Case) ProtoMessage() {}
+func (*ProtoMethod) ProtoMessage() {}
 
-func (x *MockAPI_Case) ProtoReflect() protoreflect.Message {
+func (x *ProtoMethod) ProtoReflect() protoreflect.Message {
 	mi := &file_apis_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -708,36 +740,103 @@ func (x *MockAPI_Case) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Case.ProtoReflect.Descriptor instead.
-func (*MockAPI_Case) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use ProtoMethod.ProtoReflect.Descriptor instead.
+func (*ProtoMethod) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MockAPI_Case) GetCondition() *MockAPI_Condition {
+func (x *ProtoMethod) GetName() string {
 	if x != nil {
-		return x.Condition
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoMethod) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProtoMethod) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ProtoMethod) GetClientStreaming() bool {
+	if x != nil {
+		return x.ClientStreaming
+	}
+	return false
+}
+
+func (x *ProtoMethod) GetServerStreaming() bool {
+	if x != nil {
+		return x.ServerStreaming
+	}
+	return false
+}
+
+func (x *ProtoMethod) GetInputType() *ProtoMessage {
+	if x != nil {
+		return x.InputType
 	}
 	return nil
 }
 
-func (x *MockAPI_Case) GetResponse() *MockAPI_Response {
+func (x *ProtoMethod) GetOutputType() *ProtoMessage {
 	if x != nil {
-		return x.Response
+		return x.OutputType
 	}
 	return nil
 }
 
-type MockAPI_Condition_SimpleCondition struct {
+func (x *ProtoMethod) GetDependencies() []*ProtoMessage {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *ProtoMethod) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ProtoMethod) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ProtoMethod) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+// ProtoService describes a loaded gRPC service
+type ProtoService struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items           []*MockAPI_Condition_SimpleCondition_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	UseOrAmongItems bool                                      `protobuf:"varint,2,opt,name=useOrAmongItems,proto3" json:"useOrAmongItems,omitempty"`
+	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Methods    []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	Comment    string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	File       string   `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Repository string   `protobuf:"bytes,5,opt,name=repository,proto3" json:"repository,omitempty"`
 }
 
-func (x *MockAPI_Condition_SimpleCondition) Reset() {
-	*x = MockAPI_Condition_SimpleCondition{}
+func (x *ProtoService) Reset() {
+	*x = ProtoService{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_apis_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -745,13 +844,13 @@ func (x *MockAPI_Condition_SimpleCondition) Reset() {
 	}
 }
 
-func (x *MockAPI_Condition_SimpleCondition) String() string {
+func (x *ProtoService) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Condition_SimpleCondition) ProtoMessage() {}
+func (*ProtoService) ProtoMessage() {}
 
-func (x *MockAPI_Condition_SimpleCondition) ProtoReflect() protoreflect.Message {
+func (x *ProtoService) ProtoReflect() protoreflect.Message {
 	mi := &file_apis_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -763,36 +862,63 @@ func (x *MockAPI_Condition_SimpleCondition) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Condition_SimpleCondition.ProtoReflect.Descriptor instead.
-func (*MockAPI_Condition_SimpleCondition) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 0, 0}
+// Deprecated: Use ProtoService.ProtoReflect.Descriptor instead.
+func (*ProtoService) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MockAPI_Condition_SimpleCondition) GetItems() []*MockAPI_Condition_SimpleCondition_Item {
+func (x *ProtoService) GetName() string {
 	if x != nil {
-		return x.Items
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoService) GetMethods() []string {
+	if x != nil {
+		return x.Methods
 	}
 	return nil
 }
 
-func (x *MockAPI_Condition_SimpleCondition) GetUseOrAmongItems() bool {
+func (x *ProtoService) GetComment() string {
 	if x != nil {
-		return x.UseOrAmongItems
+		return x.Comment
 	}
-	return false
+	return ""
 }
 
-type MockAPI_Condition_ScriptCondition struct {
+func (x *ProtoService) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ProtoService) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+// ProtoFile describes a loaded proto file
+type ProtoFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lang    string `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Package      string   `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	Services     []string `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty"`
+	Dependencies []string `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Repository   string   `protobuf:"bytes,5,opt,name=repository,proto3" json:"repository,omitempty"`
+	// uploaded is true if the file is uploaded through UploadProtoFiles
+	Uploaded bool `protobuf:"varint,6,opt,name=uploaded,proto3" json:"uploaded,omitempty"`
 }
 
-func (x *MockAPI_Condition_ScriptCondition) Reset() {
-	*x = MockAPI_Condition_ScriptCondition{}
+func (x *ProtoFile) Reset() {
+	*x = ProtoFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_apis_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,13 +926,13 @@ func (x *MockAPI_Condition_ScriptCondition) Reset() {
 	}
 }
 
-func (x *MockAPI_Condition_ScriptCondition) String() string {
+func (x *ProtoFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Condition_ScriptCondition) ProtoMessage() {}
+func (*ProtoFile) ProtoMessage() {}
 
-func (x *MockAPI_Condition_ScriptCondition) ProtoReflect() protoreflect.Message {
+func (x *ProtoFile) ProtoReflect() protoreflect.Message {
 	mi := &file_apis_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,38 +944,65 @@ func (x *MockAPI_Condition_ScriptCondition) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Condition_ScriptCondition.ProtoReflect.Descriptor instead.
-func (*MockAPI_Condition_ScriptCondition) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 0, 1}
+// Deprecated: Use ProtoFile.ProtoReflect.Descriptor instead.
+func (*ProtoFile) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MockAPI_Condition_ScriptCondition) GetLang() string {
+func (x *ProtoFile) GetName() string {
 	if x != nil {
-		return x.Lang
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MockAPI_Condition_ScriptCondition) GetContent() string {
+func (x *ProtoFile) GetPackage() string {
 	if x != nil {
-		return x.Content
+		return x.Package
 	}
 	return ""
 }
 
-type MockAPI_Condition_SimpleCondition_Item struct {
+func (x *ProtoFile) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ProtoFile) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *ProtoFile) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *ProtoFile) GetUploaded() bool {
+	if x != nil {
+		return x.Uploaded
+	}
+	return false
+}
+
+// ProtoLoadError describes an error encountered while loading proto files
+type ProtoLoadError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OperandX string `protobuf:"bytes,1,opt,name=operandX,proto3" json:"operandX,omitempty"`
-	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	OperandY string `protobuf:"bytes,3,opt,name=operandY,proto3" json:"operandY,omitempty"`
-	Opposite bool   `protobuf:"varint,4,opt,name=opposite,proto3" json:"opposite,omitempty"`
+	File    string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *MockAPI_Condition_SimpleCondition_Item) Reset() {
-	*x = MockAPI_Condition_SimpleCondition_Item{}
+func (x *ProtoLoadError) Reset() {
+	*x = ProtoLoadError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_apis_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -857,13 +1010,13 @@ func (x *MockAPI_Condition_SimpleCondition_Item) Reset() {
 	}
 }
 
-func (x *MockAPI_Condition_SimpleCondition_Item) String() string {
+func (x *ProtoLoadError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Condition_SimpleCondition_Item) ProtoMessage() {}
+func (*ProtoLoadError) ProtoMessage() {}
 
-func (x *MockAPI_Condition_SimpleCondition_Item) ProtoReflect() protoreflect.Message {
+func (x *ProtoLoadError) ProtoReflect() protoreflect.Message {
 	mi := &file_apis_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,67 +1028,107 @@ func (x *MockAPI_Condition_SimpleCondition_Item) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Condition_SimpleCondition_Item.ProtoReflect.Descriptor instead.
-func (*MockAPI_Condition_SimpleCondition_Item) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+// Deprecated: Use ProtoLoadError.ProtoReflect.Descriptor instead.
+func (*ProtoLoadError) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MockAPI_Condition_SimpleCondition_Item) GetOperandX() string {
+func (x *ProtoLoadError) GetFile() string {
 	if x != nil {
-		return x.OperandX
+		return x.File
 	}
 	return ""
 }
 
-func (x *MockAPI_Condition_SimpleCondition_Item) GetOperator() string {
+func (x *ProtoLoadError) GetMessage() string {
 	if x != nil {
-		return x.Operator
+		return x.Message
 	}
 	return ""
 }
 
-func (x *MockAPI_Condition_SimpleCondition_Item) GetOperandY() string {
+type ListProtoFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keywords   string       `protobuf:"bytes,1,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	Pagination *ListOptions `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListProtoFilesRequest) Reset() {
+	*x = ListProtoFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtoFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtoFilesRequest) ProtoMessage() {}
+
+func (x *ListProtoFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtoFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListProtoFilesRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListProtoFilesRequest) GetKeywords() string {
 	if x != nil {
-		return x.OperandY
+		return x.Keywords
 	}
 	return ""
 }
 
-func (x *MockAPI_Condition_SimpleCondition_Item) GetOpposite() bool {
+func (x *ListProtoFilesRequest) GetPagination() *ListOptions {
 	if x != nil {
-		return x.Opposite
+		return x.Pagination
 	}
-	return false
+	return nil
 }
 
-type MockAPI_Response_SimpleResponse struct {
+type ListProtoFilesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code    uint32            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Header  map[string]string `protobuf:"bytes,2,rep,name=header,proto3" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Trailer map[string]string `protobuf:"bytes,3,rep,name=trailer,proto3" json:"trailer,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Body    string            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Data       []*ProtoFile      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Errors     []*ProtoLoadError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Pagination *ListResponse     `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *MockAPI_Response_SimpleResponse) Reset() {
-	*x = MockAPI_Response_SimpleResponse{}
+func (x *ListProtoFilesResponse) Reset() {
+	*x = ListProtoFilesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_msgTypes[15]
+		mi := &file_apis_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MockAPI_Response_SimpleResponse) String() string {
+func (x *ListProtoFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Response_SimpleResponse) ProtoMessage() {}
+func (*ListProtoFilesResponse) ProtoMessage() {}
 
-func (x *MockAPI_Response_SimpleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_msgTypes[15]
+func (x *ListProtoFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,66 +1139,113 @@ func (x *MockAPI_Response_SimpleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Response_SimpleResponse.ProtoReflect.Descriptor instead.
-func (*MockAPI_Response_SimpleResponse) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 1, 0}
+// Deprecated: Use ListProtoFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListProtoFilesResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MockAPI_Response_SimpleResponse) GetCode() uint32 {
+func (x *ListProtoFilesResponse) GetData() []*ProtoFile {
 	if x != nil {
-		return x.Code
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *MockAPI_Response_SimpleResponse) GetHeader() map[string]string {
+func (x *ListProtoFilesResponse) GetErrors() []*ProtoLoadError {
 	if x != nil {
-		return x.Header
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *MockAPI_Response_SimpleResponse) GetTrailer() map[string]string {
+func (x *ListProtoFilesResponse) GetPagination() *ListResponse {
 	if x != nil {
-		return x.Trailer
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *MockAPI_Response_SimpleResponse) GetBody() string {
+type ListProtoServicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keywords   string       `protobuf:"bytes,1,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	Pagination *ListOptions `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListProtoServicesRequest) Reset() {
+	*x = ListProtoServicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtoServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtoServicesRequest) ProtoMessage() {}
+
+func (x *ListProtoServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtoServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListProtoServicesRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListProtoServicesRequest) GetKeywords() string {
 	if x != nil {
-		return x.Body
+		return x.Keywords
 	}
 	return ""
 }
 
-type MockAPI_Response_ScriptResponse struct {
+func (x *ListProtoServicesRequest) GetPagination() *ListOptions {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListProtoServicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lang    string               `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
-	Content string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Data       []*ProtoService `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination *ListResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *MockAPI_Response_ScriptResponse) Reset() {
-	*x = MockAPI_Response_ScriptResponse{}
+func (x *ListProtoServicesResponse) Reset() {
+	*x = ListProtoServicesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_apis_proto_msgTypes[16]
+		mi := &file_apis_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MockAPI_Response_ScriptResponse) String() string {
+func (x *ListProtoServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockAPI_Response_ScriptResponse) ProtoMessage() {}
+func (*ListProtoServicesResponse) ProtoMessage() {}
 
-func (x *MockAPI_Response_ScriptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apis_proto_msgTypes[16]
+func (x *ListProtoServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,273 +1256,4235 @@ func (x *MockAPI_Response_ScriptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockAPI_Response_ScriptResponse.ProtoReflect.Descriptor instead.
-func (*MockAPI_Response_ScriptResponse) Descriptor() ([]byte, []int) {
-	return file_apis_proto_rawDescGZIP(), []int{0, 1, 1}
+// Deprecated: Use ListProtoServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListProtoServicesResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MockAPI_Response_ScriptResponse) GetLang() string {
+func (x *ListProtoServicesResponse) GetData() []*ProtoService {
 	if x != nil {
-		return x.Lang
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListProtoServicesResponse) GetPagination() *ListResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListProtoMethodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keywords string `protobuf:"bytes,1,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	// service is used to filter methods by the fully qualified service name
+	Service    string       `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Pagination *ListOptions `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListProtoMethodsRequest) Reset() {
+	*x = ListProtoMethodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProtoMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProtoMethodsRequest) ProtoMessage() {}
+
+func (x *ListProtoMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProtoMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListProtoMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListProtoMethodsRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
 	}
 	return ""
 }
 
-func (x *MockAPI_Response_ScriptResponse) GetContent() string {
+func (x *ListProtoMethodsRequest) GetService() string {
 	if x != nil {
-		return x.Content
+		return x.Service
 	}
 	return ""
 }
 
-func (x *MockAPI_Response_ScriptResponse) GetTimeout() *durationpb.Duration {
+func (x *ListProtoMethodsRequest) GetPagination() *ListOptions {
 	if x != nil {
-		return x.Timeout
+		return x.Pagination
 	}
 	return nil
 }
 
-var File_apis_proto protoreflect.FileDescriptor
+type ListProtoMethodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_apis_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x0b, 0x0a, 0x07, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x12,
-	0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x3b, 0x0a,
-	0x05, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43,
-	0x61, 0x73, 0x65, 0x52, 0x05, 0x63, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x92, 0x04, 0x0a, 0x09, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x54,
-	0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
-	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x1a, 0x8a, 0x02, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
-	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
-	0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x4f, 0x72, 0x41, 0x6d, 0x6f, 0x6e, 0x67, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x4f, 0x72, 0x41,
-	0x6d, 0x6f, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x76, 0x0a, 0x04, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x58, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x58, 0x12, 0x1a, 0x0a,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x6e, 0x64, 0x59, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x6e, 0x64, 0x59, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x65, 0x1a, 0x3f, 0x0a, 0x0f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0xa4, 0x05, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06,
-	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x12, 0x52, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x38, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41,
-	0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x1a, 0xee, 0x02, 0x0a, 0x0e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x07, 0x74, 0x72, 0x61,
-	0x69, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x39,
-	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x72, 0x61,
-	0x69, 0x6c, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x73, 0x0a, 0x0e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x97, 0x01, 0x0a, 0x04, 0x43, 0x61, 0x73, 0x65, 0x12,
-	0x48, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61,
-	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63,
-	0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x4a, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
-	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
-	0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13,
-	0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63,
-	0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
-	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x37, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x22, 0x76, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
-	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50,
-	0x49, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x92,
-	0x03, 0x0a, 0x04, 0x4d, 0x6f, 0x63, 0x6b, 0x12, 0x7f, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x4d,
-	0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f,
-	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e,
-	0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x61,
-	0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x6d, 0x6f, 0x63, 0x6b,
-	0x2f, 0x73, 0x61, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41,
-	0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x11, 0x22, 0x0c, 0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a,
-	0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50,
-	0x49, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
-	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63,
-	0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x6c, 0x69, 0x73, 0x74,
-	0x3a, 0x01, 0x2a, 0x42, 0x18, 0x5a, 0x16, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x3b, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Data       []*ProtoMethod `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination *ListResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var (
-	file_apis_proto_rawDescOnce sync.Once
-	file_apis_proto_rawDescData = file_apis_proto_rawDesc
-)
+func (x *ListProtoMethodsResponse) Reset() {
+	*x = ListProtoMethodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_apis_proto_rawDescGZIP() []byte {
-	file_apis_proto_rawDescOnce.Do(func() {
-		file_apis_proto_rawDescData = protoimpl.X.CompressGZIP(file_apis_proto_rawDescData)
-	})
-	return file_apis_proto_rawDescData
+func (x *ListProtoMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_apis_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_apis_proto_goTypes = []interface{}{
-	(*MockAPI)(nil),                                // 0: powermock.apis.v1alpha1.MockAPI
-	(*SaveMockAPIRequest)(nil),                     // 1: powermock.apis.v1alpha1.SaveMockAPIRequest
-	(*SaveMockAPIResponse)(nil),                    // 2: powermock.apis.v1alpha1.SaveMockAPIResponse
-	(*DeleteMockAPIRequest)(nil),                   // 3: powermock.apis.v1alpha1.DeleteMockAPIRequest
-	(*DeleteMockAPIResponse)(nil),                  // 4: powermock.apis.v1alpha1.DeleteMockAPIResponse
-	(*ListOptions)(nil),                            // 5: powermock.apis.v1alpha1.ListOptions
-	(*ListResponse)(nil),                           // 6: powermock.apis.v1alpha1.ListResponse
-	(*ListMockAPIRequest)(nil),                     // 7: powermock.apis.v1alpha1.ListMockAPIRequest
-	(*ListMockAPIResponse)(nil),                    // 8: powermock.apis.v1alpha1.ListMockAPIResponse
-	(*MockAPI_Condition)(nil),                      // 9: powermock.apis.v1alpha1.MockAPI.Condition
-	(*MockAPI_Response)(nil),                       // 10: powermock.apis.v1alpha1.MockAPI.Response
-	(*MockAPI_Case)(nil),                           // 11: powermock.apis.v1alpha1.MockAPI.Case
-	(*MockAPI_Condition_SimpleCondition)(nil),      // 12: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition
-	(*MockAPI_Condition_ScriptCondition)(nil),      // 13: powermock.apis.v1alpha1.MockAPI.Condition.ScriptCondition
-	(*MockAPI_Condition_SimpleCondition_Item)(nil), // 14: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Item
-	(*MockAPI_Response_SimpleResponse)(nil),        // 15: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse
-	(*MockAPI_Response_ScriptResponse)(nil),        // 16: powermock.apis.v1alpha1.MockAPI.Response.ScriptResponse
-	nil,                                            // 17: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.HeaderEntry
-	nil,                                            // 18: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.TrailerEntry
-	(*durationpb.Duration)(nil),                    // 19: google.protobuf.Duration
+func (*ListProtoMethodsResponse) ProtoMessage() {}
+
+func (x *ListProtoMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_apis_proto_depIdxs = []int32{
-	11, // 0: powermock.apis.v1alpha1.MockAPI.cases:type_name -> powermock.apis.v1alpha1.MockAPI.Case
-	0,  // 1: powermock.apis.v1alpha1.SaveMockAPIRequest.data:type_name -> powermock.apis.v1alpha1.MockAPI
-	5,  // 2: powermock.apis.v1alpha1.ListMockAPIRequest.pagination:type_name -> powermock.apis.v1alpha1.ListOptions
-	0,  // 3: powermock.apis.v1alpha1.ListMockAPIResponse.data:type_name -> powermock.apis.v1alpha1.MockAPI
-	6,  // 4: powermock.apis.v1alpha1.ListMockAPIResponse.pagination:type_name -> powermock.apis.v1alpha1.ListResponse
-	12, // 5: powermock.apis.v1alpha1.MockAPI.Condition.simple:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition
-	13, // 6: powermock.apis.v1alpha1.MockAPI.Condition.script:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.ScriptCondition
-	15, // 7: powermock.apis.v1alpha1.MockAPI.Response.simple:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse
-	16, // 8: powermock.apis.v1alpha1.MockAPI.Response.script:type_name -> powermock.apis.v1alpha1.MockAPI.Response.ScriptResponse
-	9,  // 9: powermock.apis.v1alpha1.MockAPI.Case.condition:type_name -> powermock.apis.v1alpha1.MockAPI.Condition
-	10, // 10: powermock.apis.v1alpha1.MockAPI.Case.response:type_name -> powermock.apis.v1alpha1.MockAPI.Response
-	14, // 11: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.items:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Item
-	17, // 12: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.header:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.HeaderEntry
-	18, // 13: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.trailer:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.TrailerEntry
-	19, // 14: powermock.apis.v1alpha1.MockAPI.Response.ScriptResponse.timeout:type_name -> google.protobuf.Duration
-	1,  // 15: powermock.apis.v1alpha1.Mock.SaveMockAPI:input_type -> powermock.apis.v1alpha1.SaveMockAPIRequest
-	3,  // 16: powermock.apis.v1alpha1.Mock.DeleteMockAPI:input_type -> powermock.apis.v1alpha1.DeleteMockAPIRequest
-	7,  // 17: powermock.apis.v1alpha1.Mock.ListMockAPI:input_type -> powermock.apis.v1alpha1.ListMockAPIRequest
-	2,  // 18: powermock.apis.v1alpha1.Mock.SaveMockAPI:output_type -> powermock.apis.v1alpha1.SaveMockAPIResponse
-	4,  // 19: powermock.apis.v1alpha1.Mock.DeleteMockAPI:output_type -> powermock.apis.v1alpha1.DeleteMockAPIResponse
-	8,  // 20: powermock.apis.v1alpha1.Mock.ListMockAPI:output_type -> powermock.apis.v1alpha1.ListMockAPIResponse
-	18, // [18:21] is the sub-list for method output_type
-	15, // [15:18] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+
+// Deprecated: Use ListProtoMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListProtoMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{20}
 }
 
-func init() { file_apis_proto_init() }
-func file_apis_proto_init() {
-	if File_apis_proto != nil {
-		return
+func (x *ListProtoMethodsResponse) GetData() []*ProtoMethod {
+	if x != nil {
+		return x.Data
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_apis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MockAPI); i {
+	return nil
+}
+
+func (x *ListProtoMethodsResponse) GetPagination() *ListResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// ProtoSource defines a proto file uploaded at runtime
+type ProtoSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the import path of the file, such as "examples/greeter.proto"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// content is the source of the proto file
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// descriptor is the serialized FileDescriptorProto, it is used instead of content when it is set
+	Descriptor_ []byte `protobuf:"bytes,3,opt,name=descriptor,proto3" json:"descriptor,omitempty"`
+}
+
+func (x *ProtoSource) Reset() {
+	*x = ProtoSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoSource) ProtoMessage() {}
+
+func (x *ProtoSource) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoSource.ProtoReflect.Descriptor instead.
+func (*ProtoSource) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProtoSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoSource) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ProtoSource) GetDescriptor_() []byte {
+	if x != nil {
+		return x.Descriptor_
+	}
+	return nil
+}
+
+type UploadProtoFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*ProtoSource `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// fileDescriptorSet is the serialized google.protobuf.FileDescriptorSet
+	FileDescriptorSet []byte `protobuf:"bytes,2,opt,name=fileDescriptorSet,proto3" json:"fileDescriptorSet,omitempty"`
+}
+
+func (x *UploadProtoFilesRequest) Reset() {
+	*x = UploadProtoFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadProtoFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProtoFilesRequest) ProtoMessage() {}
+
+func (x *UploadProtoFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProtoFilesRequest.ProtoReflect.Descriptor instead.
+func (*UploadProtoFilesRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadProtoFilesRequest) GetFiles() []*ProtoSource {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *UploadProtoFilesRequest) GetFileDescriptorSet() []byte {
+	if x != nil {
+		return x.FileDescriptorSet
+	}
+	return nil
+}
+
+type UploadProtoFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// methods contains the gRPC paths of methods defined in the uploaded files
+	Methods []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *UploadProtoFilesResponse) Reset() {
+	*x = UploadProtoFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadProtoFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProtoFilesResponse) ProtoMessage() {}
+
+func (x *UploadProtoFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProtoFilesResponse.ProtoReflect.Descriptor instead.
+func (*UploadProtoFilesResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadProtoFilesResponse) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type DeleteProtoFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *DeleteProtoFilesRequest) Reset() {
+	*x = DeleteProtoFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProtoFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProtoFilesRequest) ProtoMessage() {}
+
+func (x *DeleteProtoFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProtoFilesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProtoFilesRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteProtoFilesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type DeleteProtoFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteProtoFilesResponse) Reset() {
+	*x = DeleteProtoFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProtoFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProtoFilesResponse) ProtoMessage() {}
+
+func (x *DeleteProtoFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProtoFilesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProtoFilesResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{25}
+}
+
+// Blob defines binary data uploaded at runtime, it is referenced by MockAPI.Response.BodySource
+type Blob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+}
+
+func (x *Blob) Reset() {
+	*x = Blob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Blob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blob) ProtoMessage() {}
+
+func (x *Blob) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blob.ProtoReflect.Descriptor instead.
+func (*Blob) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Blob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Blob) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Blob) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *Blob `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UploadBlobRequest) Reset() {
+	*x = UploadBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBlobRequest) ProtoMessage() {}
+
+func (x *UploadBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadBlobRequest.ProtoReflect.Descriptor instead.
+func (*UploadBlobRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UploadBlobRequest) GetData() *Blob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UploadBlobResponse) Reset() {
+	*x = UploadBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBlobResponse) ProtoMessage() {}
+
+func (x *UploadBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadBlobResponse.ProtoReflect.Descriptor instead.
+func (*UploadBlobResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{28}
+}
+
+type DeleteBlobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteBlobRequest) Reset() {
+	*x = DeleteBlobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBlobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlobRequest) ProtoMessage() {}
+
+func (x *DeleteBlobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlobRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteBlobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteBlobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBlobResponse) Reset() {
+	*x = DeleteBlobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBlobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlobResponse) ProtoMessage() {}
+
+func (x *DeleteBlobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBlobResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{30}
+}
+
+// BlobInfo describes an uploaded blob without its data
+type BlobInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size        uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+}
+
+func (x *BlobInfo) Reset() {
+	*x = BlobInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobInfo) ProtoMessage() {}
+
+func (x *BlobInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobInfo.ProtoReflect.Descriptor instead.
+func (*BlobInfo) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BlobInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlobInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BlobInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type ListBlobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keywords   string       `protobuf:"bytes,1,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	Pagination *ListOptions `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListBlobsRequest) Reset() {
+	*x = ListBlobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlobsRequest) ProtoMessage() {}
+
+func (x *ListBlobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlobsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlobsRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListBlobsRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+func (x *ListBlobsRequest) GetPagination() *ListOptions {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListBlobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data       []*BlobInfo   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Pagination *ListResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListBlobsResponse) Reset() {
+	*x = ListBlobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlobsResponse) ProtoMessage() {}
+
+func (x *ListBlobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlobsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlobsResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListBlobsResponse) GetData() []*BlobInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListBlobsResponse) GetPagination() *ListResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type MockAPI_Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Condition:
+	//	*MockAPI_Condition_Simple
+	//	*MockAPI_Condition_Script
+	//	*MockAPI_Condition_Wasm
+	//	*MockAPI_Condition_External
+	Condition isMockAPI_Condition_Condition `protobuf_oneof:"Condition"`
+}
+
+func (x *MockAPI_Condition) Reset() {
+	*x = MockAPI_Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Condition) ProtoMessage() {}
+
+func (x *MockAPI_Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Condition.ProtoReflect.Descriptor instead.
+func (*MockAPI_Condition) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (m *MockAPI_Condition) GetCondition() isMockAPI_Condition_Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition) GetSimple() *MockAPI_Condition_SimpleCondition {
+	if x, ok := x.GetCondition().(*MockAPI_Condition_Simple); ok {
+		return x.Simple
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition) GetScript() *MockAPI_Condition_ScriptCondition {
+	if x, ok := x.GetCondition().(*MockAPI_Condition_Script); ok {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition) GetWasm() *MockAPI_Condition_WasmCondition {
+	if x, ok := x.GetCondition().(*MockAPI_Condition_Wasm); ok {
+		return x.Wasm
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition) GetExternal() *MockAPI_Condition_ExternalCondition {
+	if x, ok := x.GetCondition().(*MockAPI_Condition_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+type isMockAPI_Condition_Condition interface {
+	isMockAPI_Condition_Condition()
+}
+
+type MockAPI_Condition_Simple struct {
+	Simple *MockAPI_Condition_SimpleCondition `protobuf:"bytes,1,opt,name=simple,proto3,oneof"`
+}
+
+type MockAPI_Condition_Script struct {
+	Script *MockAPI_Condition_ScriptCondition `protobuf:"bytes,2,opt,name=script,proto3,oneof"`
+}
+
+type MockAPI_Condition_Wasm struct {
+	Wasm *MockAPI_Condition_WasmCondition `protobuf:"bytes,3,opt,name=wasm,proto3,oneof"`
+}
+
+type MockAPI_Condition_External struct {
+	External *MockAPI_Condition_ExternalCondition `protobuf:"bytes,4,opt,name=external,proto3,oneof"`
+}
+
+func (*MockAPI_Condition_Simple) isMockAPI_Condition_Condition() {}
+
+func (*MockAPI_Condition_Script) isMockAPI_Condition_Condition() {}
+
+func (*MockAPI_Condition_Wasm) isMockAPI_Condition_Condition() {}
+
+func (*MockAPI_Condition_External) isMockAPI_Condition_Condition() {}
+
+type MockAPI_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*MockAPI_Response_Simple
+	//	*MockAPI_Response_Script
+	//	*MockAPI_Response_Websocket
+	//	*MockAPI_Response_Wasm
+	//	*MockAPI_Response_External
+	Response isMockAPI_Response_Response `protobuf_oneof:"Response"`
+}
+
+func (x *MockAPI_Response) Reset() {
+	*x = MockAPI_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response) ProtoMessage() {}
+
+func (x *MockAPI_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (m *MockAPI_Response) GetResponse() isMockAPI_Response_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *MockAPI_Response) GetSimple() *MockAPI_Response_SimpleResponse {
+	if x, ok := x.GetResponse().(*MockAPI_Response_Simple); ok {
+		return x.Simple
+	}
+	return nil
+}
+
+func (x *MockAPI_Response) GetScript() *MockAPI_Response_ScriptResponse {
+	if x, ok := x.GetResponse().(*MockAPI_Response_Script); ok {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *MockAPI_Response) GetWebsocket() *MockAPI_Response_WebSocketResponse {
+	if x, ok := x.GetResponse().(*MockAPI_Response_Websocket); ok {
+		return x.Websocket
+	}
+	return nil
+}
+
+func (x *MockAPI_Response) GetWasm() *MockAPI_Response_WasmResponse {
+	if x, ok := x.GetResponse().(*MockAPI_Response_Wasm); ok {
+		return x.Wasm
+	}
+	return nil
+}
+
+func (x *MockAPI_Response) GetExternal() *MockAPI_Response_ExternalResponse {
+	if x, ok := x.GetResponse().(*MockAPI_Response_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+type isMockAPI_Response_Response interface {
+	isMockAPI_Response_Response()
+}
+
+type MockAPI_Response_Simple struct {
+	Simple *MockAPI_Response_SimpleResponse `protobuf:"bytes,1,opt,name=simple,proto3,oneof"`
+}
+
+type MockAPI_Response_Script struct {
+	Script *MockAPI_Response_ScriptResponse `protobuf:"bytes,2,opt,name=script,proto3,oneof"`
+}
+
+type MockAPI_Response_Websocket struct {
+	Websocket *MockAPI_Response_WebSocketResponse `protobuf:"bytes,3,opt,name=websocket,proto3,oneof"`
+}
+
+type MockAPI_Response_Wasm struct {
+	Wasm *MockAPI_Response_WasmResponse `protobuf:"bytes,4,opt,name=wasm,proto3,oneof"`
+}
+
+type MockAPI_Response_External struct {
+	External *MockAPI_Response_ExternalResponse `protobuf:"bytes,5,opt,name=external,proto3,oneof"`
+}
+
+func (*MockAPI_Response_Simple) isMockAPI_Response_Response() {}
+
+func (*MockAPI_Response_Script) isMockAPI_Response_Response() {}
+
+func (*MockAPI_Response_Websocket) isMockAPI_Response_Response() {}
+
+func (*MockAPI_Response_Wasm) isMockAPI_Response_Response() {}
+
+func (*MockAPI_Response_External) isMockAPI_Response_Response() {}
+
+type MockAPI_Case struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Condition *MockAPI_Condition `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Response  *MockAPI_Response  `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *MockAPI_Case) Reset() {
+	*x = MockAPI_Case{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Case) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Case) ProtoMessage() {}
+
+func (x *MockAPI_Case) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Case.ProtoReflect.Descriptor instead.
+func (*MockAPI_Case) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MockAPI_Case) GetCondition() *MockAPI_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *MockAPI_Case) GetResponse() *MockAPI_Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type MockAPI_Condition_SimpleCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items           []*MockAPI_Condition_SimpleCondition_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	UseOrAmongItems bool                                      `protobuf:"varint,2,opt,name=useOrAmongItems,proto3" json:"useOrAmongItems,omitempty"`
+	// all, any and not are evaluated with short-circuiting as the expressions of the same names,
+	// the condition matches if items, all, any and not which are specified all match
+	All []*MockAPI_Condition_SimpleCondition_Expression `protobuf:"bytes,3,rep,name=all,proto3" json:"all,omitempty"`
+	Any []*MockAPI_Condition_SimpleCondition_Expression `protobuf:"bytes,4,rep,name=any,proto3" json:"any,omitempty"`
+	Not *MockAPI_Condition_SimpleCondition_Expression   `protobuf:"bytes,5,opt,name=not,proto3" json:"not,omitempty"`
+}
+
+func (x *MockAPI_Condition_SimpleCondition) Reset() {
+	*x = MockAPI_Condition_SimpleCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Condition_SimpleCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Condition_SimpleCondition) ProtoMessage() {}
+
+func (x *MockAPI_Condition_SimpleCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Condition_SimpleCondition.ProtoReflect.Descriptor instead.
+func (*MockAPI_Condition_SimpleCondition) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *MockAPI_Condition_SimpleCondition) GetItems() []*MockAPI_Condition_SimpleCondition_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition_SimpleCondition) GetUseOrAmongItems() bool {
+	if x != nil {
+		return x.UseOrAmongItems
+	}
+	return false
+}
+
+func (x *MockAPI_Condition_SimpleCondition) GetAll() []*MockAPI_Condition_SimpleCondition_Expression {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition_SimpleCondition) GetAny() []*MockAPI_Condition_SimpleCondition_Expression {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition_SimpleCondition) GetNot() *MockAPI_Condition_SimpleCondition_Expression {
+	if x != nil {
+		return x.Not
+	}
+	return nil
+}
+
+type MockAPI_Condition_ScriptCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lang is javascript, lua or cel (Common Expression Language),
+	// the expression of cel should return a bool
+	Lang    string `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *MockAPI_Condition_ScriptCondition) Reset() {
+	*x = MockAPI_Condition_ScriptCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Condition_ScriptCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Condition_ScriptCondition) ProtoMessage() {}
+
+func (x *MockAPI_Condition_ScriptCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Condition_ScriptCondition.ProtoReflect.Descriptor instead.
+func (*MockAPI_Condition_ScriptCondition) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *MockAPI_Condition_ScriptCondition) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *MockAPI_Condition_ScriptCondition) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// WasmCondition is matched by the match function of WASM module, see docs/wasm.md for the ABI
+type MockAPI_Condition_WasmCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module is the name of module, which is loaded from the module directory or uploaded as a blob
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// config is passed to the module as a part of the condition
+	Config *structpb.Value `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *MockAPI_Condition_WasmCondition) Reset() {
+	*x = MockAPI_Condition_WasmCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Condition_WasmCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Condition_WasmCondition) ProtoMessage() {}
+
+func (x *MockAPI_Condition_WasmCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Condition_WasmCondition.ProtoReflect.Descriptor instead.
+func (*MockAPI_Condition_WasmCondition) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *MockAPI_Condition_WasmCondition) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *MockAPI_Condition_WasmCondition) GetConfig() *structpb.Value {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// ExternalCondition is matched by the external plugin process of the given name, see docs/plugin.md for the protocol
+type MockAPI_Condition_ExternalCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// plugin is the name of external plugin declared in the config file
+	Plugin string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// config is passed to the plugin as a part of the condition
+	Config *structpb.Value `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *MockAPI_Condition_ExternalCondition) Reset() {
+	*x = MockAPI_Condition_ExternalCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Condition_ExternalCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Condition_ExternalCondition) ProtoMessage() {}
+
+func (x *MockAPI_Condition_ExternalCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Condition_ExternalCondition.ProtoReflect.Descriptor instead.
+func (*MockAPI_Condition_ExternalCondition) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 0, 3}
+}
+
+func (x *MockAPI_Condition_ExternalCondition) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *MockAPI_Condition_ExternalCondition) GetConfig() *structpb.Value {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type MockAPI_Condition_SimpleCondition_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperandX string `protobuf:"bytes,1,opt,name=operandX,proto3" json:"operandX,omitempty"`
+	// operator is one of =, ==, ===, !=, >, >=, <, <=, regex, in (substring of operandY),
+	// exists, notExists, contains, startsWith (prefix), endsWith (suffix), equalsIgnoreCase,
+	// oneOf (a JSON array or comma separated values), semver==, semver>, semver>=, semver<, semver<=,
+	// before, after (RFC3339, date, unix timestamp or now+-duration), cidr (comma separated CIDRs),
+	// jsonSchema (operandY is the schema) and jsonPath (matched if the result is not empty)
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	OperandY string `protobuf:"bytes,3,opt,name=operandY,proto3" json:"operandY,omitempty"`
+	Opposite bool   `protobuf:"varint,4,opt,name=opposite,proto3" json:"opposite,omitempty"`
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Item) Reset() {
+	*x = MockAPI_Condition_SimpleCondition_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Condition_SimpleCondition_Item) ProtoMessage() {}
+
+func (x *MockAPI_Condition_SimpleCondition_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Condition_SimpleCondition_Item.ProtoReflect.Descriptor instead.
+func (*MockAPI_Condition_SimpleCondition_Item) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Item) GetOperandX() string {
+	if x != nil {
+		return x.OperandX
+	}
+	return ""
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Item) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Item) GetOperandY() string {
+	if x != nil {
+		return x.OperandY
+	}
+	return ""
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Item) GetOpposite() bool {
+	if x != nil {
+		return x.Opposite
+	}
+	return false
+}
+
+// Expression defines a nested boolean expression, exactly one of the fields should be set:
+// item matches if the item matches, all matches if all of expressions match,
+// any matches if any of expressions matches, not matches if the expression does not match
+type MockAPI_Condition_SimpleCondition_Expression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *MockAPI_Condition_SimpleCondition_Item         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	All  []*MockAPI_Condition_SimpleCondition_Expression `protobuf:"bytes,2,rep,name=all,proto3" json:"all,omitempty"`
+	Any  []*MockAPI_Condition_SimpleCondition_Expression `protobuf:"bytes,3,rep,name=any,proto3" json:"any,omitempty"`
+	Not  *MockAPI_Condition_SimpleCondition_Expression   `protobuf:"bytes,4,opt,name=not,proto3" json:"not,omitempty"`
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Expression) Reset() {
+	*x = MockAPI_Condition_SimpleCondition_Expression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Expression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Condition_SimpleCondition_Expression) ProtoMessage() {}
+
+func (x *MockAPI_Condition_SimpleCondition_Expression) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Condition_SimpleCondition_Expression.ProtoReflect.Descriptor instead.
+func (*MockAPI_Condition_SimpleCondition_Expression) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 0, 0, 1}
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Expression) GetItem() *MockAPI_Condition_SimpleCondition_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Expression) GetAll() []*MockAPI_Condition_SimpleCondition_Expression {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Expression) GetAny() []*MockAPI_Condition_SimpleCondition_Expression {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *MockAPI_Condition_SimpleCondition_Expression) GetNot() *MockAPI_Condition_SimpleCondition_Expression {
+	if x != nil {
+		return x.Not
+	}
+	return nil
+}
+
+// Values defines multiple values of a header or trailer
+type MockAPI_Response_Values struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *MockAPI_Response_Values) Reset() {
+	*x = MockAPI_Response_Values{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_Values) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_Values) ProtoMessage() {}
+
+func (x *MockAPI_Response_Values) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_Values.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_Values) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *MockAPI_Response_Values) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Cookie defines a cookie sent as the set-cookie header
+type MockAPI_Response_Cookie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Domain string `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	// maxAge is the Max-Age attribute in seconds,
+	// 0 means unspecified and a negative value means deleting the cookie now
+	MaxAge   int32 `protobuf:"varint,5,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	Secure   bool  `protobuf:"varint,6,opt,name=secure,proto3" json:"secure,omitempty"`
+	HttpOnly bool  `protobuf:"varint,7,opt,name=httpOnly,proto3" json:"httpOnly,omitempty"`
+	// sameSite is one of lax, strict and none
+	SameSite string `protobuf:"bytes,8,opt,name=sameSite,proto3" json:"sameSite,omitempty"`
+}
+
+func (x *MockAPI_Response_Cookie) Reset() {
+	*x = MockAPI_Response_Cookie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_Cookie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_Cookie) ProtoMessage() {}
+
+func (x *MockAPI_Response_Cookie) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_Cookie.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_Cookie) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 1}
+}
+
+func (x *MockAPI_Response_Cookie) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_Cookie) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_Cookie) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_Cookie) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_Cookie) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *MockAPI_Response_Cookie) GetSecure() bool {
+	if x != nil {
+		return x.Secure
+	}
+	return false
+}
+
+func (x *MockAPI_Response_Cookie) GetHttpOnly() bool {
+	if x != nil {
+		return x.HttpOnly
+	}
+	return false
+}
+
+func (x *MockAPI_Response_Cookie) GetSameSite() string {
+	if x != nil {
+		return x.SameSite
+	}
+	return ""
+}
+
+// BodySource defines a binary body which is sent as is without rendering
+type MockAPI_Response_BodySource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*MockAPI_Response_BodySource_Base64
+	//	*MockAPI_Response_BodySource_File
+	//	*MockAPI_Response_BodySource_Blob
+	Source isMockAPI_Response_BodySource_Source `protobuf_oneof:"Source"`
+	// contentType is used as the content-type header unless it is set in header,
+	// it is detected by the file extension or the content if it is empty
+	ContentType string `protobuf:"bytes,4,opt,name=contentType,proto3" json:"contentType,omitempty"`
+}
+
+func (x *MockAPI_Response_BodySource) Reset() {
+	*x = MockAPI_Response_BodySource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_BodySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_BodySource) ProtoMessage() {}
+
+func (x *MockAPI_Response_BodySource) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_BodySource.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_BodySource) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 2}
+}
+
+func (m *MockAPI_Response_BodySource) GetSource() isMockAPI_Response_BodySource_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_BodySource) GetBase64() string {
+	if x, ok := x.GetSource().(*MockAPI_Response_BodySource_Base64); ok {
+		return x.Base64
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_BodySource) GetFile() string {
+	if x, ok := x.GetSource().(*MockAPI_Response_BodySource_File); ok {
+		return x.File
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_BodySource) GetBlob() string {
+	if x, ok := x.GetSource().(*MockAPI_Response_BodySource_Blob); ok {
+		return x.Blob
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_BodySource) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type isMockAPI_Response_BodySource_Source interface {
+	isMockAPI_Response_BodySource_Source()
+}
+
+type MockAPI_Response_BodySource_Base64 struct {
+	// base64 is the base64 encoded body
+	Base64 string `protobuf:"bytes,1,opt,name=base64,proto3,oneof"`
+}
+
+type MockAPI_Response_BodySource_File struct {
+	// file is the path relative to the fixtures directory of simple plugin
+	File string `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+type MockAPI_Response_BodySource_Blob struct {
+	// blob is the name of blob uploaded through UploadBlob
+	Blob string `protobuf:"bytes,3,opt,name=blob,proto3,oneof"`
+}
+
+func (*MockAPI_Response_BodySource_Base64) isMockAPI_Response_BodySource_Source() {}
+
+func (*MockAPI_Response_BodySource_File) isMockAPI_Response_BodySource_Source() {}
+
+func (*MockAPI_Response_BodySource_Blob) isMockAPI_Response_BodySource_Source() {}
+
+// Status defines the message and details of gRPC error responses, it is only used if code is not 0,
+// details are encoded as google.protobuf.Any in grpc-status-details-bin
+type MockAPI_Response_Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// message is rendered in the same way as body
+	Message string                           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Details []*MockAPI_Response_StatusDetail `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *MockAPI_Response_Status) Reset() {
+	*x = MockAPI_Response_Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_Status) ProtoMessage() {}
+
+func (x *MockAPI_Response_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_Status.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_Status) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 3}
+}
+
+func (x *MockAPI_Response_Status) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_Status) GetDetails() []*MockAPI_Response_StatusDetail {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// StatusDetail defines a detail of gRPC status,
+// errorInfo, badRequest and retryInfo are encoded as the messages with the same names in package google.rpc
+type MockAPI_Response_StatusDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Detail:
+	//	*MockAPI_Response_StatusDetail_ErrorInfo_
+	//	*MockAPI_Response_StatusDetail_BadRequest_
+	//	*MockAPI_Response_StatusDetail_RetryInfo_
+	//	*MockAPI_Response_StatusDetail_Message_
+	Detail isMockAPI_Response_StatusDetail_Detail `protobuf_oneof:"Detail"`
+}
+
+func (x *MockAPI_Response_StatusDetail) Reset() {
+	*x = MockAPI_Response_StatusDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_StatusDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_StatusDetail) ProtoMessage() {}
+
+func (x *MockAPI_Response_StatusDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_StatusDetail.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_StatusDetail) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 4}
+}
+
+func (m *MockAPI_Response_StatusDetail) GetDetail() isMockAPI_Response_StatusDetail_Detail {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_StatusDetail) GetErrorInfo() *MockAPI_Response_StatusDetail_ErrorInfo {
+	if x, ok := x.GetDetail().(*MockAPI_Response_StatusDetail_ErrorInfo_); ok {
+		return x.ErrorInfo
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_StatusDetail) GetBadRequest() *MockAPI_Response_StatusDetail_BadRequest {
+	if x, ok := x.GetDetail().(*MockAPI_Response_StatusDetail_BadRequest_); ok {
+		return x.BadRequest
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_StatusDetail) GetRetryInfo() *MockAPI_Response_StatusDetail_RetryInfo {
+	if x, ok := x.GetDetail().(*MockAPI_Response_StatusDetail_RetryInfo_); ok {
+		return x.RetryInfo
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_StatusDetail) GetMessage() *MockAPI_Response_StatusDetail_Message {
+	if x, ok := x.GetDetail().(*MockAPI_Response_StatusDetail_Message_); ok {
+		return x.Message
+	}
+	return nil
+}
+
+type isMockAPI_Response_StatusDetail_Detail interface {
+	isMockAPI_Response_StatusDetail_Detail()
+}
+
+type MockAPI_Response_StatusDetail_ErrorInfo_ struct {
+	ErrorInfo *MockAPI_Response_StatusDetail_ErrorInfo `protobuf:"bytes,1,opt,name=errorInfo,proto3,oneof"`
+}
+
+type MockAPI_Response_StatusDetail_BadRequest_ struct {
+	BadRequest *MockAPI_Response_StatusDetail_BadRequest `protobuf:"bytes,2,opt,name=badRequest,proto3,oneof"`
+}
+
+type MockAPI_Response_StatusDetail_RetryInfo_ struct {
+	RetryInfo *MockAPI_Response_StatusDetail_RetryInfo `protobuf:"bytes,3,opt,name=retryInfo,proto3,oneof"`
+}
+
+type MockAPI_Response_StatusDetail_Message_ struct {
+	Message *MockAPI_Response_StatusDetail_Message `protobuf:"bytes,4,opt,name=message,proto3,oneof"`
+}
+
+func (*MockAPI_Response_StatusDetail_ErrorInfo_) isMockAPI_Response_StatusDetail_Detail() {}
+
+func (*MockAPI_Response_StatusDetail_BadRequest_) isMockAPI_Response_StatusDetail_Detail() {}
+
+func (*MockAPI_Response_StatusDetail_RetryInfo_) isMockAPI_Response_StatusDetail_Detail() {}
+
+func (*MockAPI_Response_StatusDetail_Message_) isMockAPI_Response_StatusDetail_Detail() {}
+
+// StreamMessage defines a message of streaming response
+type MockAPI_Response_StreamMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Body string `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	// delay is the duration to wait before sending this message
+	Delay *durationpb.Duration `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	// event and id are used as the event type and id of Server-Sent Events,
+	// they are ignored unless the content-type of http response is text/event-stream
+	Event string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Id    string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MockAPI_Response_StreamMessage) Reset() {
+	*x = MockAPI_Response_StreamMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_StreamMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_StreamMessage) ProtoMessage() {}
+
+func (x *MockAPI_Response_StreamMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_StreamMessage.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_StreamMessage) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 5}
+}
+
+func (x *MockAPI_Response_StreamMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_StreamMessage) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_StreamMessage) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_StreamMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MockAPI_Response_SimpleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    uint32            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Header  map[string]string `protobuf:"bytes,2,rep,name=header,proto3" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Trailer map[string]string `protobuf:"bytes,3,rep,name=trailer,proto3" json:"trailer,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body    string            `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// stream is the sequence of messages to send for streaming methods,
+	// or the chunks (events) of streaming http responses,
+	// body is ignored if stream is not empty
+	Stream []*MockAPI_Response_StreamMessage `protobuf:"bytes,5,rep,name=stream,proto3" json:"stream,omitempty"`
+	// headers and trailers are used to send multiple values of the same key, such as set-cookie,
+	// the values are appended to header and trailer
+	Headers  map[string]*MockAPI_Response_Values `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Trailers map[string]*MockAPI_Response_Values `protobuf:"bytes,7,rep,name=trailers,proto3" json:"trailers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// cookies are sent as set-cookie headers
+	Cookies []*MockAPI_Response_Cookie `protobuf:"bytes,8,rep,name=cookies,proto3" json:"cookies,omitempty"`
+	// bodySource is used instead of body if it is set
+	BodySource *MockAPI_Response_BodySource `protobuf:"bytes,9,opt,name=bodySource,proto3" json:"bodySource,omitempty"`
+	// jsonBody is used instead of body if it is set, it is written as a native YAML or JSON value,
+	// placeholders are rendered in string leaves, and a leaf which only contains a placeholder
+	// with a type, such as "{{ $request.query.page | number }}", is replaced by the typed value,
+	// the supported types are number, bool and json
+	JsonBody *structpb.Value `protobuf:"bytes,10,opt,name=jsonBody,proto3" json:"jsonBody,omitempty"`
+	// status is used to specify the message and details of gRPC errors
+	Status *MockAPI_Response_Status `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	// template is the engine used to render code, headers, cookies, trailers, status message and bodies:
+	// - "" (default): {{ $request.<path> }} and {{ $mock.<name> }} placeholders,
+	//   values of headers and trailers are rendered if they are a $request or $mock path
+	// - "go": the text/template of Go with helper functions, the request can be accessed as {{ .request.<field> }}
+	Template string `protobuf:"bytes,12,opt,name=template,proto3" json:"template,omitempty"`
+	// codeTemplate is rendered by the template engine and used instead of code if it is not empty
+	CodeTemplate string `protobuf:"bytes,13,opt,name=codeTemplate,proto3" json:"codeTemplate,omitempty"`
+	// fakerSeed is rendered like the values of headers and used as the seed of $mock values and random functions,
+	// so that the same value, such as $request.header.uid, always generates the same fake data
+	FakerSeed string `protobuf:"bytes,14,opt,name=fakerSeed,proto3" json:"fakerSeed,omitempty"`
+}
+
+func (x *MockAPI_Response_SimpleResponse) Reset() {
+	*x = MockAPI_Response_SimpleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_SimpleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_SimpleResponse) ProtoMessage() {}
+
+func (x *MockAPI_Response_SimpleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_SimpleResponse.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_SimpleResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 6}
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetHeader() map[string]string {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetTrailer() map[string]string {
+	if x != nil {
+		return x.Trailer
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetStream() []*MockAPI_Response_StreamMessage {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetHeaders() map[string]*MockAPI_Response_Values {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetTrailers() map[string]*MockAPI_Response_Values {
+	if x != nil {
+		return x.Trailers
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetCookies() []*MockAPI_Response_Cookie {
+	if x != nil {
+		return x.Cookies
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetBodySource() *MockAPI_Response_BodySource {
+	if x != nil {
+		return x.BodySource
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetJsonBody() *structpb.Value {
+	if x != nil {
+		return x.JsonBody
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetStatus() *MockAPI_Response_Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetCodeTemplate() string {
+	if x != nil {
+		return x.CodeTemplate
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_SimpleResponse) GetFakerSeed() string {
+	if x != nil {
+		return x.FakerSeed
+	}
+	return ""
+}
+
+type MockAPI_Response_ScriptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lang is javascript, lua or cel (Common Expression Language),
+	// the script should return a map of code, header, trailer and body
+	Lang    string               `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Content string               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *MockAPI_Response_ScriptResponse) Reset() {
+	*x = MockAPI_Response_ScriptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_ScriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_ScriptResponse) ProtoMessage() {}
+
+func (x *MockAPI_Response_ScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_ScriptResponse.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_ScriptResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 7}
+}
+
+func (x *MockAPI_Response_ScriptResponse) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_ScriptResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_ScriptResponse) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// WebSocketResponse defines a script of frames for WebSocket connections,
+// bodies of frames are rendered in the same way as the body of SimpleResponse
+type MockAPI_Response_WebSocketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// header is the extra headers of the handshake response
+	Header map[string]string `protobuf:"bytes,1,rep,name=header,proto3" json:"header,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// onConnect is the frames sent once the connection is established
+	OnConnect []*MockAPI_Response_WebSocketResponse_Frame    `protobuf:"bytes,2,rep,name=onConnect,proto3" json:"onConnect,omitempty"`
+	Replies   []*MockAPI_Response_WebSocketResponse_Reply    `protobuf:"bytes,3,rep,name=replies,proto3" json:"replies,omitempty"`
+	Periodic  []*MockAPI_Response_WebSocketResponse_Periodic `protobuf:"bytes,4,rep,name=periodic,proto3" json:"periodic,omitempty"`
+	// close is used to close the connection after the delay since the onConnect frames are sent
+	Close *MockAPI_Response_WebSocketResponse_Close `protobuf:"bytes,5,opt,name=close,proto3" json:"close,omitempty"`
+}
+
+func (x *MockAPI_Response_WebSocketResponse) Reset() {
+	*x = MockAPI_Response_WebSocketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_WebSocketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_WebSocketResponse) ProtoMessage() {}
+
+func (x *MockAPI_Response_WebSocketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_WebSocketResponse.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_WebSocketResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 8}
+}
+
+func (x *MockAPI_Response_WebSocketResponse) GetHeader() map[string]string {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse) GetOnConnect() []*MockAPI_Response_WebSocketResponse_Frame {
+	if x != nil {
+		return x.OnConnect
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse) GetReplies() []*MockAPI_Response_WebSocketResponse_Reply {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse) GetPeriodic() []*MockAPI_Response_WebSocketResponse_Periodic {
+	if x != nil {
+		return x.Periodic
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse) GetClose() *MockAPI_Response_WebSocketResponse_Close {
+	if x != nil {
+		return x.Close
+	}
+	return nil
+}
+
+// WasmResponse is generated by the mock function of WASM module, see docs/wasm.md for the ABI
+type MockAPI_Response_WasmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module is the name of module, which is loaded from the module directory or uploaded as a blob
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// config is passed to the module as a part of the response
+	Config *structpb.Value `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// timeout is the time limit of execution, it can not exceed the timeout of plugin
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *MockAPI_Response_WasmResponse) Reset() {
+	*x = MockAPI_Response_WasmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_WasmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_WasmResponse) ProtoMessage() {}
+
+func (x *MockAPI_Response_WasmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_WasmResponse.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_WasmResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 9}
+}
+
+func (x *MockAPI_Response_WasmResponse) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_WasmResponse) GetConfig() *structpb.Value {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WasmResponse) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// ExternalResponse is generated by the external plugin process of the given name, see docs/plugin.md for the protocol
+type MockAPI_Response_ExternalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// plugin is the name of external plugin declared in the config file
+	Plugin string `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// config is passed to the plugin as a part of the response
+	Config *structpb.Value `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// timeout is the time limit of the call, it can not exceed the timeout of plugin
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *MockAPI_Response_ExternalResponse) Reset() {
+	*x = MockAPI_Response_ExternalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_ExternalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_ExternalResponse) ProtoMessage() {}
+
+func (x *MockAPI_Response_ExternalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_ExternalResponse.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_ExternalResponse) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 10}
+}
+
+func (x *MockAPI_Response_ExternalResponse) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_ExternalResponse) GetConfig() *structpb.Value {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_ExternalResponse) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type MockAPI_Response_StatusDetail_ErrorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason   string            `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Domain   string            `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MockAPI_Response_StatusDetail_ErrorInfo) Reset() {
+	*x = MockAPI_Response_StatusDetail_ErrorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_StatusDetail_ErrorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_StatusDetail_ErrorInfo) ProtoMessage() {}
+
+func (x *MockAPI_Response_StatusDetail_ErrorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_StatusDetail_ErrorInfo.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_StatusDetail_ErrorInfo) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 4, 0}
+}
+
+func (x *MockAPI_Response_StatusDetail_ErrorInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_StatusDetail_ErrorInfo) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_StatusDetail_ErrorInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type MockAPI_Response_StatusDetail_BadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldViolations []*MockAPI_Response_StatusDetail_BadRequest_FieldViolation `protobuf:"bytes,1,rep,name=fieldViolations,proto3" json:"fieldViolations,omitempty"`
+}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest) Reset() {
+	*x = MockAPI_Response_StatusDetail_BadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_StatusDetail_BadRequest) ProtoMessage() {}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_StatusDetail_BadRequest.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_StatusDetail_BadRequest) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 4, 1}
+}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest) GetFieldViolations() []*MockAPI_Response_StatusDetail_BadRequest_FieldViolation {
+	if x != nil {
+		return x.FieldViolations
+	}
+	return nil
+}
+
+type MockAPI_Response_StatusDetail_RetryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RetryDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=retryDelay,proto3" json:"retryDelay,omitempty"`
+}
+
+func (x *MockAPI_Response_StatusDetail_RetryInfo) Reset() {
+	*x = MockAPI_Response_StatusDetail_RetryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_StatusDetail_RetryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_StatusDetail_RetryInfo) ProtoMessage() {}
+
+func (x *MockAPI_Response_StatusDetail_RetryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_StatusDetail_RetryInfo.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_StatusDetail_RetryInfo) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 4, 2}
+}
+
+func (x *MockAPI_Response_StatusDetail_RetryInfo) GetRetryDelay() *durationpb.Duration {
+	if x != nil {
+		return x.RetryDelay
+	}
+	return nil
+}
+
+// Message defines a message of any type which can be resolved from the loaded proto files
+// or the well-known types, such as google.rpc.QuotaFailure
+type MockAPI_Response_StatusDetail_Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the fully qualified name of message
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// value is the JSON format of message, placeholders are rendered in the same way as jsonBody
+	Value *structpb.Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MockAPI_Response_StatusDetail_Message) Reset() {
+	*x = MockAPI_Response_StatusDetail_Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_StatusDetail_Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_StatusDetail_Message) ProtoMessage() {}
+
+func (x *MockAPI_Response_StatusDetail_Message) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_StatusDetail_Message.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_StatusDetail_Message) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 4, 3}
+}
+
+func (x *MockAPI_Response_StatusDetail_Message) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_StatusDetail_Message) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type MockAPI_Response_StatusDetail_BadRequest_FieldViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field       string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest_FieldViolation) Reset() {
+	*x = MockAPI_Response_StatusDetail_BadRequest_FieldViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest_FieldViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_StatusDetail_BadRequest_FieldViolation) ProtoMessage() {}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest_FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_StatusDetail_BadRequest_FieldViolation.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_StatusDetail_BadRequest_FieldViolation) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 4, 1, 0}
+}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest_FieldViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_StatusDetail_BadRequest_FieldViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type MockAPI_Response_WebSocketResponse_Frame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is one of text (default) and binary, the body of binary frame is base64 encoded
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// delay is the duration to wait before sending this frame
+	Delay *durationpb.Duration `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Frame) Reset() {
+	*x = MockAPI_Response_WebSocketResponse_Frame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Frame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_WebSocketResponse_Frame) ProtoMessage() {}
+
+func (x *MockAPI_Response_WebSocketResponse_Frame) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_WebSocketResponse_Frame.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_WebSocketResponse_Frame) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 8, 0}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Frame) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Frame) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Frame) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+type MockAPI_Response_WebSocketResponse_Close struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is the close code, it defaults to 1000 (normal closure)
+	Code   uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// delay is the duration to wait before closing
+	Delay *durationpb.Duration `protobuf:"bytes,3,opt,name=delay,proto3" json:"delay,omitempty"`
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Close) Reset() {
+	*x = MockAPI_Response_WebSocketResponse_Close{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Close) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_WebSocketResponse_Close) ProtoMessage() {}
+
+func (x *MockAPI_Response_WebSocketResponse_Close) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_WebSocketResponse_Close.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_WebSocketResponse_Close) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 8, 1}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Close) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Close) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Close) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+// Reply defines frames to reply to an incoming message,
+// the first reply whose condition matches the incoming message is used
+type MockAPI_Response_WebSocketResponse_Reply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// condition is matched against the incoming message as $request.body,
+	// it matches any message if it is empty
+	Condition *MockAPI_Condition                          `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Frames    []*MockAPI_Response_WebSocketResponse_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	// close is used to close the connection after replying
+	Close *MockAPI_Response_WebSocketResponse_Close `protobuf:"bytes,3,opt,name=close,proto3" json:"close,omitempty"`
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Reply) Reset() {
+	*x = MockAPI_Response_WebSocketResponse_Reply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_WebSocketResponse_Reply) ProtoMessage() {}
+
+func (x *MockAPI_Response_WebSocketResponse_Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_WebSocketResponse_Reply.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_WebSocketResponse_Reply) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 8, 2}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Reply) GetCondition() *MockAPI_Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Reply) GetFrames() []*MockAPI_Response_WebSocketResponse_Frame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Reply) GetClose() *MockAPI_Response_WebSocketResponse_Close {
+	if x != nil {
+		return x.Close
+	}
+	return nil
+}
+
+// Periodic defines a frame sent at a fixed interval
+type MockAPI_Response_WebSocketResponse_Periodic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frame    *MockAPI_Response_WebSocketResponse_Frame `protobuf:"bytes,1,opt,name=frame,proto3" json:"frame,omitempty"`
+	Interval *durationpb.Duration                      `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// count is the maximum times to send the frame, 0 means unlimited
+	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Periodic) Reset() {
+	*x = MockAPI_Response_WebSocketResponse_Periodic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_apis_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Periodic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockAPI_Response_WebSocketResponse_Periodic) ProtoMessage() {}
+
+func (x *MockAPI_Response_WebSocketResponse_Periodic) ProtoReflect() protoreflect.Message {
+	mi := &file_apis_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockAPI_Response_WebSocketResponse_Periodic.ProtoReflect.Descriptor instead.
+func (*MockAPI_Response_WebSocketResponse_Periodic) Descriptor() ([]byte, []int) {
+	return file_apis_proto_rawDescGZIP(), []int{0, 1, 8, 3}
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Periodic) GetFrame() *MockAPI_Response_WebSocketResponse_Frame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Periodic) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *MockAPI_Response_WebSocketResponse_Periodic) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_apis_proto protoreflect.FileDescriptor
+
+var file_apis_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xe8, 0x34, 0x0a, 0x07, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x63,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x61, 0x73,
+	0x65, 0x52, 0x05, 0x63, 0x61, 0x73, 0x65, 0x73, 0x1a, 0xee, 0x0b, 0x0a, 0x09, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x54, 0x0a, 0x06,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x4e, 0x0a, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41,
+	0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x61, 0x73,
+	0x6d, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x77, 0x61,
+	0x73, 0x6d, 0x12, 0x5a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x1a, 0x84,
+	0x07, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b,
+	0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65,
+	0x4f, 0x72, 0x41, 0x6d, 0x6f, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x4f, 0x72, 0x41, 0x6d, 0x6f, 0x6e, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x57, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41,
+	0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x57, 0x0a, 0x03,
+	0x61, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x57, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63,
+	0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x1a, 0x76,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e,
+	0x64, 0x58, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e,
+	0x64, 0x58, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x59, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x59, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x1a, 0xec, 0x02, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f,
+	0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x57, 0x0a, 0x03, 0x61, 0x6c,
+	0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x03,
+	0x61, 0x6c, 0x6c, 0x12, 0x57, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41,
+	0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x57, 0x0a, 0x03,
+	0x6e, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x03, 0x6e, 0x6f, 0x74, 0x1a, 0x3f, 0x0a, 0x0f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x57, 0x0a, 0x0d, 0x57, 0x61, 0x73, 0x6d, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
+	0x5b, 0x0a, 0x11, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0x0a, 0x09,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xb6, 0x26, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x5b,
+	0x0a, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b,
+	0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x09, 0x77, 0x65, 0x62, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x4c, 0x0a, 0x04, 0x77,
+	0x61, 0x73, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x04, 0x77, 0x61, 0x73, 0x6d, 0x12, 0x58, 0x0a, 0x08, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x1a, 0x20, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xc6, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x61, 0x6d, 0x65, 0x53, 0x69, 0x74, 0x65, 0x1a, 0x7e,
+	0x0a, 0x0a, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x06,
+	0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x04,
+	0x62, 0x6c, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6c,
+	0x6f, 0x62, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x74,
+	0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f,
+	0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x1a, 0xee, 0x07, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x60, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x63, 0x0a, 0x0a, 0x62, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x2e, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0a, 0x62, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x09,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x40, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50,
+	0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5a,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50,
+	0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0xe4, 0x01, 0x0a, 0x09, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x6a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0xd2, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x7a, 0x0a, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x2e, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x48, 0x0a, 0x0e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x46, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x72, 0x79, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x1a, 0x4b,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x7a, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x1a, 0xdf, 0x09, 0x0a, 0x0e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x5c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x4f, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x5f, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x62, 0x0a,
+	0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x46, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50,
+	0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72,
+	0x73, 0x12, 0x4a, 0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63,
+	0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12, 0x54, 0x0a,
+	0x0a, 0x62, 0x6f, 0x64, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b,
+	0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x6f, 0x64,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6a,
+	0x73, 0x6f, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x1a,
+	0x39, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x72,
+	0x61, 0x69, 0x6c, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6c, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x73, 0x0a, 0x0e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0xab, 0x09, 0x0a, 0x11, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x5f, 0x0a, 0x09, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63,
+	0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65,
+	0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x09, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x12, 0x5b, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x41, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b,
+	0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x60, 0x0a,
+	0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x44, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50,
+	0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x69, 0x63, 0x52, 0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x12,
+	0x57, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x1a, 0x60, 0x0a, 0x05, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x1a, 0x64, 0x0a, 0x05, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x1a, 0x85, 0x02, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x48, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x57, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x1a, 0xb0, 0x01, 0x0a, 0x08, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x69, 0x63, 0x12, 0x57, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x8b, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x73, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x1a, 0x8f, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x1a, 0x97, 0x01, 0x0a, 0x04, 0x43, 0x61, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x12,
+	0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41,
+	0x50, 0x49, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65,
+	0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x34, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x76, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x44, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe0, 0x01, 0x0a, 0x0a, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x4d, 0x61, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x79, 0x0a,
+	0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc8, 0x03, 0x0a, 0x0b, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x43,
+	0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x8a, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0xb5, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x22, 0x3e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x44, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f,
+	0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7c,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x01, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x95, 0x01, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x22,
+	0x83, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x53, 0x65, 0x74, 0x22, 0x34, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x04, 0x42, 0x6c, 0x6f, 0x62,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x46, 0x0a, 0x11, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x62, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x74, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x44, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x8b, 0x0c, 0x0a, 0x04, 0x4d, 0x6f, 0x63, 0x6b,
+	0x12, 0x7f, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x12,
+	0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f,
+	0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41,
+	0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x3a, 0x01,
+	0x2a, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b,
+	0x41, 0x50, 0x49, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x6d, 0x6f, 0x63,
+	0x6b, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0b, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x12, 0x2b, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f,
+	0x6d, 0x6f, 0x63, 0x6b, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x8f, 0x01, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x9b,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x97, 0x01, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x12, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x97, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x01, 0x2a,
+	0x12, 0x97, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63,
+	0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x22, 0x13, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x0a, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
+	0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b,
+	0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
+	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d,
+	0x6f, 0x63, 0x6b, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x6d, 0x6f, 0x63, 0x6b, 0x2e, 0x61,
+	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x3a, 0x01, 0x2a, 0x42, 0x18, 0x5a, 0x16, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_apis_proto_rawDescOnce sync.Once
+	file_apis_proto_rawDescData = file_apis_proto_rawDesc
+)
+
+func file_apis_proto_rawDescGZIP() []byte {
+	file_apis_proto_rawDescOnce.Do(func() {
+		file_apis_proto_rawDescData = protoimpl.X.CompressGZIP(file_apis_proto_rawDescData)
+	})
+	return file_apis_proto_rawDescData
+}
+
+var file_apis_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_apis_proto_goTypes = []interface{}{
+	(*MockAPI)(nil),                                      // 0: powermock.apis.v1alpha1.MockAPI
+	(*SaveMockAPIRequest)(nil),                           // 1: powermock.apis.v1alpha1.SaveMockAPIRequest
+	(*SaveMockAPIResponse)(nil),                          // 2: powermock.apis.v1alpha1.SaveMockAPIResponse
+	(*DeleteMockAPIRequest)(nil),                         // 3: powermock.apis.v1alpha1.DeleteMockAPIRequest
+	(*DeleteMockAPIResponse)(nil),                        // 4: powermock.apis.v1alpha1.DeleteMockAPIResponse
+	(*ListOptions)(nil),                                  // 5: powermock.apis.v1alpha1.ListOptions
+	(*ListResponse)(nil),                                 // 6: powermock.apis.v1alpha1.ListResponse
+	(*ListMockAPIRequest)(nil),                           // 7: powermock.apis.v1alpha1.ListMockAPIRequest
+	(*ListMockAPIResponse)(nil),                          // 8: powermock.apis.v1alpha1.ListMockAPIResponse
+	(*ProtoField)(nil),                                   // 9: powermock.apis.v1alpha1.ProtoField
+	(*ProtoMessage)(nil),                                 // 10: powermock.apis.v1alpha1.ProtoMessage
+	(*ProtoMethod)(nil),                                  // 11: powermock.apis.v1alpha1.ProtoMethod
+	(*ProtoService)(nil),                                 // 12: powermock.apis.v1alpha1.ProtoService
+	(*ProtoFile)(nil),                                    // 13: powermock.apis.v1alpha1.ProtoFile
+	(*ProtoLoadError)(nil),                               // 14: powermock.apis.v1alpha1.ProtoLoadError
+	(*ListProtoFilesRequest)(nil),                        // 15: powermock.apis.v1alpha1.ListProtoFilesRequest
+	(*ListProtoFilesResponse)(nil),                       // 16: powermock.apis.v1alpha1.ListProtoFilesResponse
+	(*ListProtoServicesRequest)(nil),                     // 17: powermock.apis.v1alpha1.ListProtoServicesRequest
+	(*ListProtoServicesResponse)(nil),                    // 18: powermock.apis.v1alpha1.ListProtoServicesResponse
+	(*ListProtoMethodsRequest)(nil),                      // 19: powermock.apis.v1alpha1.ListProtoMethodsRequest
+	(*ListProtoMethodsResponse)(nil),                     // 20: powermock.apis.v1alpha1.ListProtoMethodsResponse
+	(*ProtoSource)(nil),                                  // 21: powermock.apis.v1alpha1.ProtoSource
+	(*UploadProtoFilesRequest)(nil),                      // 22: powermock.apis.v1alpha1.UploadProtoFilesRequest
+	(*UploadProtoFilesResponse)(nil),                     // 23: powermock.apis.v1alpha1.UploadProtoFilesResponse
+	(*DeleteProtoFilesRequest)(nil),                      // 24: powermock.apis.v1alpha1.DeleteProtoFilesRequest
+	(*DeleteProtoFilesResponse)(nil),                     // 25: powermock.apis.v1alpha1.DeleteProtoFilesResponse
+	(*Blob)(nil),                                         // 26: powermock.apis.v1alpha1.Blob
+	(*UploadBlobRequest)(nil),                            // 27: powermock.apis.v1alpha1.UploadBlobRequest
+	(*UploadBlobResponse)(nil),                           // 28: powermock.apis.v1alpha1.UploadBlobResponse
+	(*DeleteBlobRequest)(nil),                            // 29: powermock.apis.v1alpha1.DeleteBlobRequest
+	(*DeleteBlobResponse)(nil),                           // 30: powermock.apis.v1alpha1.DeleteBlobResponse
+	(*BlobInfo)(nil),                                     // 31: powermock.apis.v1alpha1.BlobInfo
+	(*ListBlobsRequest)(nil),                             // 32: powermock.apis.v1alpha1.ListBlobsRequest
+	(*ListBlobsResponse)(nil),                            // 33: powermock.apis.v1alpha1.ListBlobsResponse
+	(*MockAPI_Condition)(nil),                            // 34: powermock.apis.v1alpha1.MockAPI.Condition
+	(*MockAPI_Response)(nil),                             // 35: powermock.apis.v1alpha1.MockAPI.Response
+	(*MockAPI_Case)(nil),                                 // 36: powermock.apis.v1alpha1.MockAPI.Case
+	(*MockAPI_Condition_SimpleCondition)(nil),            // 37: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition
+	(*MockAPI_Condition_ScriptCondition)(nil),            // 38: powermock.apis.v1alpha1.MockAPI.Condition.ScriptCondition
+	(*MockAPI_Condition_WasmCondition)(nil),              // 39: powermock.apis.v1alpha1.MockAPI.Condition.WasmCondition
+	(*MockAPI_Condition_ExternalCondition)(nil),          // 40: powermock.apis.v1alpha1.MockAPI.Condition.ExternalCondition
+	(*MockAPI_Condition_SimpleCondition_Item)(nil),       // 41: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Item
+	(*MockAPI_Condition_SimpleCondition_Expression)(nil), // 42: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression
+	(*MockAPI_Response_Values)(nil),                      // 43: powermock.apis.v1alpha1.MockAPI.Response.Values
+	(*MockAPI_Response_Cookie)(nil),                      // 44: powermock.apis.v1alpha1.MockAPI.Response.Cookie
+	(*MockAPI_Response_BodySource)(nil),                  // 45: powermock.apis.v1alpha1.MockAPI.Response.BodySource
+	(*MockAPI_Response_Status)(nil),                      // 46: powermock.apis.v1alpha1.MockAPI.Response.Status
+	(*MockAPI_Response_StatusDetail)(nil),                // 47: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail
+	(*MockAPI_Response_StreamMessage)(nil),               // 48: powermock.apis.v1alpha1.MockAPI.Response.StreamMessage
+	(*MockAPI_Response_SimpleResponse)(nil),              // 49: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse
+	(*MockAPI_Response_ScriptResponse)(nil),              // 50: powermock.apis.v1alpha1.MockAPI.Response.ScriptResponse
+	(*MockAPI_Response_WebSocketResponse)(nil),           // 51: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse
+	(*MockAPI_Response_WasmResponse)(nil),                // 52: powermock.apis.v1alpha1.MockAPI.Response.WasmResponse
+	(*MockAPI_Response_ExternalResponse)(nil),            // 53: powermock.apis.v1alpha1.MockAPI.Response.ExternalResponse
+	(*MockAPI_Response_StatusDetail_ErrorInfo)(nil),      // 54: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.ErrorInfo
+	(*MockAPI_Response_StatusDetail_BadRequest)(nil),     // 55: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.BadRequest
+	(*MockAPI_Response_StatusDetail_RetryInfo)(nil),      // 56: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.RetryInfo
+	(*MockAPI_Response_StatusDetail_Message)(nil),        // 57: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.Message
+	nil, // 58: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.ErrorInfo.MetadataEntry
+	(*MockAPI_Response_StatusDetail_BadRequest_FieldViolation)(nil), // 59: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.BadRequest.FieldViolation
+	nil, // 60: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.HeaderEntry
+	nil, // 61: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.TrailerEntry
+	nil, // 62: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.HeadersEntry
+	nil, // 63: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.TrailersEntry
+	(*MockAPI_Response_WebSocketResponse_Frame)(nil),    // 64: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Frame
+	(*MockAPI_Response_WebSocketResponse_Close)(nil),    // 65: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Close
+	(*MockAPI_Response_WebSocketResponse_Reply)(nil),    // 66: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Reply
+	(*MockAPI_Response_WebSocketResponse_Periodic)(nil), // 67: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Periodic
+	nil,                         // 68: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.HeaderEntry
+	(*structpb.Value)(nil),      // 69: google.protobuf.Value
+	(*durationpb.Duration)(nil), // 70: google.protobuf.Duration
+}
+var file_apis_proto_depIdxs = []int32{
+	36, // 0: powermock.apis.v1alpha1.MockAPI.cases:type_name -> powermock.apis.v1alpha1.MockAPI.Case
+	0,  // 1: powermock.apis.v1alpha1.SaveMockAPIRequest.data:type_name -> powermock.apis.v1alpha1.MockAPI
+	5,  // 2: powermock.apis.v1alpha1.ListMockAPIRequest.pagination:type_name -> powermock.apis.v1alpha1.ListOptions
+	0,  // 3: powermock.apis.v1alpha1.ListMockAPIResponse.data:type_name -> powermock.apis.v1alpha1.MockAPI
+	6,  // 4: powermock.apis.v1alpha1.ListMockAPIResponse.pagination:type_name -> powermock.apis.v1alpha1.ListResponse
+	9,  // 5: powermock.apis.v1alpha1.ProtoMessage.fields:type_name -> powermock.apis.v1alpha1.ProtoField
+	10, // 6: powermock.apis.v1alpha1.ProtoMethod.inputType:type_name -> powermock.apis.v1alpha1.ProtoMessage
+	10, // 7: powermock.apis.v1alpha1.ProtoMethod.outputType:type_name -> powermock.apis.v1alpha1.ProtoMessage
+	10, // 8: powermock.apis.v1alpha1.ProtoMethod.dependencies:type_name -> powermock.apis.v1alpha1.ProtoMessage
+	5,  // 9: powermock.apis.v1alpha1.ListProtoFilesRequest.pagination:type_name -> powermock.apis.v1alpha1.ListOptions
+	13, // 10: powermock.apis.v1alpha1.ListProtoFilesResponse.data:type_name -> powermock.apis.v1alpha1.ProtoFile
+	14, // 11: powermock.apis.v1alpha1.ListProtoFilesResponse.errors:type_name -> powermock.apis.v1alpha1.ProtoLoadError
+	6,  // 12: powermock.apis.v1alpha1.ListProtoFilesResponse.pagination:type_name -> powermock.apis.v1alpha1.ListResponse
+	5,  // 13: powermock.apis.v1alpha1.ListProtoServicesRequest.pagination:type_name -> powermock.apis.v1alpha1.ListOptions
+	12, // 14: powermock.apis.v1alpha1.ListProtoServicesResponse.data:type_name -> powermock.apis.v1alpha1.ProtoService
+	6,  // 15: powermock.apis.v1alpha1.ListProtoServicesResponse.pagination:type_name -> powermock.apis.v1alpha1.ListResponse
+	5,  // 16: powermock.apis.v1alpha1.ListProtoMethodsRequest.pagination:type_name -> powermock.apis.v1alpha1.ListOptions
+	11, // 17: powermock.apis.v1alpha1.ListProtoMethodsResponse.data:type_name -> powermock.apis.v1alpha1.ProtoMethod
+	6,  // 18: powermock.apis.v1alpha1.ListProtoMethodsResponse.pagination:type_name -> powermock.apis.v1alpha1.ListResponse
+	21, // 19: powermock.apis.v1alpha1.UploadProtoFilesRequest.files:type_name -> powermock.apis.v1alpha1.ProtoSource
+	26, // 20: powermock.apis.v1alpha1.UploadBlobRequest.data:type_name -> powermock.apis.v1alpha1.Blob
+	5,  // 21: powermock.apis.v1alpha1.ListBlobsRequest.pagination:type_name -> powermock.apis.v1alpha1.ListOptions
+	31, // 22: powermock.apis.v1alpha1.ListBlobsResponse.data:type_name -> powermock.apis.v1alpha1.BlobInfo
+	6,  // 23: powermock.apis.v1alpha1.ListBlobsResponse.pagination:type_name -> powermock.apis.v1alpha1.ListResponse
+	37, // 24: powermock.apis.v1alpha1.MockAPI.Condition.simple:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition
+	38, // 25: powermock.apis.v1alpha1.MockAPI.Condition.script:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.ScriptCondition
+	39, // 26: powermock.apis.v1alpha1.MockAPI.Condition.wasm:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.WasmCondition
+	40, // 27: powermock.apis.v1alpha1.MockAPI.Condition.external:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.ExternalCondition
+	49, // 28: powermock.apis.v1alpha1.MockAPI.Response.simple:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse
+	50, // 29: powermock.apis.v1alpha1.MockAPI.Response.script:type_name -> powermock.apis.v1alpha1.MockAPI.Response.ScriptResponse
+	51, // 30: powermock.apis.v1alpha1.MockAPI.Response.websocket:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse
+	52, // 31: powermock.apis.v1alpha1.MockAPI.Response.wasm:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WasmResponse
+	53, // 32: powermock.apis.v1alpha1.MockAPI.Response.external:type_name -> powermock.apis.v1alpha1.MockAPI.Response.ExternalResponse
+	34, // 33: powermock.apis.v1alpha1.MockAPI.Case.condition:type_name -> powermock.apis.v1alpha1.MockAPI.Condition
+	35, // 34: powermock.apis.v1alpha1.MockAPI.Case.response:type_name -> powermock.apis.v1alpha1.MockAPI.Response
+	41, // 35: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.items:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Item
+	42, // 36: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.all:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression
+	42, // 37: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.any:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression
+	42, // 38: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.not:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression
+	69, // 39: powermock.apis.v1alpha1.MockAPI.Condition.WasmCondition.config:type_name -> google.protobuf.Value
+	69, // 40: powermock.apis.v1alpha1.MockAPI.Condition.ExternalCondition.config:type_name -> google.protobuf.Value
+	41, // 41: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression.item:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Item
+	42, // 42: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression.all:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression
+	42, // 43: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression.any:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression
+	42, // 44: powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression.not:type_name -> powermock.apis.v1alpha1.MockAPI.Condition.SimpleCondition.Expression
+	47, // 45: powermock.apis.v1alpha1.MockAPI.Response.Status.details:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StatusDetail
+	54, // 46: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.errorInfo:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.ErrorInfo
+	55, // 47: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.badRequest:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.BadRequest
+	56, // 48: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.retryInfo:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.RetryInfo
+	57, // 49: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.message:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.Message
+	70, // 50: powermock.apis.v1alpha1.MockAPI.Response.StreamMessage.delay:type_name -> google.protobuf.Duration
+	60, // 51: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.header:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.HeaderEntry
+	61, // 52: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.trailer:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.TrailerEntry
+	48, // 53: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.stream:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StreamMessage
+	62, // 54: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.headers:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.HeadersEntry
+	63, // 55: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.trailers:type_name -> powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.TrailersEntry
+	44, // 56: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.cookies:type_name -> powermock.apis.v1alpha1.MockAPI.Response.Cookie
+	45, // 57: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.bodySource:type_name -> powermock.apis.v1alpha1.MockAPI.Response.BodySource
+	69, // 58: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.jsonBody:type_name -> google.protobuf.Value
+	46, // 59: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.status:type_name -> powermock.apis.v1alpha1.MockAPI.Response.Status
+	70, // 60: powermock.apis.v1alpha1.MockAPI.Response.ScriptResponse.timeout:type_name -> google.protobuf.Duration
+	68, // 61: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.header:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.HeaderEntry
+	64, // 62: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.onConnect:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Frame
+	66, // 63: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.replies:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Reply
+	67, // 64: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.periodic:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Periodic
+	65, // 65: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.close:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Close
+	69, // 66: powermock.apis.v1alpha1.MockAPI.Response.WasmResponse.config:type_name -> google.protobuf.Value
+	70, // 67: powermock.apis.v1alpha1.MockAPI.Response.WasmResponse.timeout:type_name -> google.protobuf.Duration
+	69, // 68: powermock.apis.v1alpha1.MockAPI.Response.ExternalResponse.config:type_name -> google.protobuf.Value
+	70, // 69: powermock.apis.v1alpha1.MockAPI.Response.ExternalResponse.timeout:type_name -> google.protobuf.Duration
+	58, // 70: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.ErrorInfo.metadata:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.ErrorInfo.MetadataEntry
+	59, // 71: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.BadRequest.fieldViolations:type_name -> powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.BadRequest.FieldViolation
+	70, // 72: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.RetryInfo.retryDelay:type_name -> google.protobuf.Duration
+	69, // 73: powermock.apis.v1alpha1.MockAPI.Response.StatusDetail.Message.value:type_name -> google.protobuf.Value
+	43, // 74: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.HeadersEntry.value:type_name -> powermock.apis.v1alpha1.MockAPI.Response.Values
+	43, // 75: powermock.apis.v1alpha1.MockAPI.Response.SimpleResponse.TrailersEntry.value:type_name -> powermock.apis.v1alpha1.MockAPI.Response.Values
+	70, // 76: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Frame.delay:type_name -> google.protobuf.Duration
+	70, // 77: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Close.delay:type_name -> google.protobuf.Duration
+	34, // 78: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Reply.condition:type_name -> powermock.apis.v1alpha1.MockAPI.Condition
+	64, // 79: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Reply.frames:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Frame
+	65, // 80: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Reply.close:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Close
+	64, // 81: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Periodic.frame:type_name -> powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Frame
+	70, // 82: powermock.apis.v1alpha1.MockAPI.Response.WebSocketResponse.Periodic.interval:type_name -> google.protobuf.Duration
+	1,  // 83: powermock.apis.v1alpha1.Mock.SaveMockAPI:input_type -> powermock.apis.v1alpha1.SaveMockAPIRequest
+	3,  // 84: powermock.apis.v1alpha1.Mock.DeleteMockAPI:input_type -> powermock.apis.v1alpha1.DeleteMockAPIRequest
+	7,  // 85: powermock.apis.v1alpha1.Mock.ListMockAPI:input_type -> powermock.apis.v1alpha1.ListMockAPIRequest
+	15, // 86: powermock.apis.v1alpha1.Mock.ListProtoFiles:input_type -> powermock.apis.v1alpha1.ListProtoFilesRequest
+	17, // 87: powermock.apis.v1alpha1.Mock.ListProtoServices:input_type -> powermock.apis.v1alpha1.ListProtoServicesRequest
+	19, // 88: powermock.apis.v1alpha1.Mock.ListProtoMethods:input_type -> powermock.apis.v1alpha1.ListProtoMethodsRequest
+	22, // 89: powermock.apis.v1alpha1.Mock.UploadProtoFiles:input_type -> powermock.apis.v1alpha1.UploadProtoFilesRequest
+	24, // 90: powermock.apis.v1alpha1.Mock.DeleteProtoFiles:input_type -> powermock.apis.v1alpha1.DeleteProtoFilesRequest
+	27, // 91: powermock.apis.v1alpha1.Mock.UploadBlob:input_type -> powermock.apis.v1alpha1.UploadBlobRequest
+	29, // 92: powermock.apis.v1alpha1.Mock.DeleteBlob:input_type -> powermock.apis.v1alpha1.DeleteBlobRequest
+	32, // 93: powermock.apis.v1alpha1.Mock.ListBlobs:input_type -> powermock.apis.v1alpha1.ListBlobsRequest
+	2,  // 94: powermock.apis.v1alpha1.Mock.SaveMockAPI:output_type -> powermock.apis.v1alpha1.SaveMockAPIResponse
+	4,  // 95: powermock.apis.v1alpha1.Mock.DeleteMockAPI:output_type -> powermock.apis.v1alpha1.DeleteMockAPIResponse
+	8,  // 96: powermock.apis.v1alpha1.Mock.ListMockAPI:output_type -> powermock.apis.v1alpha1.ListMockAPIResponse
+	16, // 97: powermock.apis.v1alpha1.Mock.ListProtoFiles:output_type -> powermock.apis.v1alpha1.ListProtoFilesResponse
+	18, // 98: powermock.apis.v1alpha1.Mock.ListProtoServices:output_type -> powermock.apis.v1alpha1.ListProtoServicesResponse
+	20, // 99: powermock.apis.v1alpha1.Mock.ListProtoMethods:output_type -> powermock.apis.v1alpha1.ListProtoMethodsResponse
+	23, // 100: powermock.apis.v1alpha1.Mock.UploadProtoFiles:output_type -> powermock.apis.v1alpha1.UploadProtoFilesResponse
+	25, // 101: powermock.apis.v1alpha1.Mock.DeleteProtoFiles:output_type -> powermock.apis.v1alpha1.DeleteProtoFilesResponse
+	28, // 102: powermock.apis.v1alpha1.Mock.UploadBlob:output_type -> powermock.apis.v1alpha1.UploadBlobResponse
+	30, // 103: powermock.apis.v1alpha1.Mock.DeleteBlob:output_type -> powermock.apis.v1alpha1.DeleteBlobResponse
+	33, // 104: powermock.apis.v1alpha1.Mock.ListBlobs:output_type -> powermock.apis.v1alpha1.ListBlobsResponse
+	94, // [94:105] is the sub-list for method output_type
+	83, // [83:94] is the sub-list for method input_type
+	83, // [83:83] is the sub-list for extension type_name
+	83, // [83:83] is the sub-list for extension extendee
+	0,  // [0:83] is the sub-list for field type_name
+}
+
+func init() { file_apis_proto_init() }
+func file_apis_proto_init() {
+	if File_apis_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_apis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMockAPIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMockAPIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMockAPIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMockAPIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMockAPIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMockAPIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMethod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoService); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoLoadError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtoFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtoFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtoServicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtoServicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtoMethodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProtoMethodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadProtoFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadProtoFilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1293,8 +5495,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveMockAPIRequest); i {
+		file_apis_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProtoFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1305,8 +5507,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveMockAPIResponse); i {
+		file_apis_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProtoFilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1317,8 +5519,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMockAPIRequest); i {
+		file_apis_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Blob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1329,8 +5531,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteMockAPIResponse); i {
+		file_apis_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBlobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1341,8 +5543,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOptions); i {
+		file_apis_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBlobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1353,8 +5555,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResponse); i {
+		file_apis_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBlobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,8 +5567,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMockAPIRequest); i {
+		file_apis_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBlobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,8 +5579,8 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMockAPIResponse); i {
+		file_apis_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1389,7 +5591,31 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Condition); i {
 			case 0:
 				return &v.state
@@ -1401,7 +5627,7 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Response); i {
 			case 0:
 				return &v.state
@@ -1413,7 +5639,7 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Case); i {
 			case 0:
 				return &v.state
@@ -1425,7 +5651,7 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Condition_SimpleCondition); i {
 			case 0:
 				return &v.state
@@ -1437,7 +5663,7 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Condition_ScriptCondition); i {
 			case 0:
 				return &v.state
@@ -1449,7 +5675,31 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Condition_WasmCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Condition_ExternalCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Condition_SimpleCondition_Item); i {
 			case 0:
 				return &v.state
@@ -1461,7 +5711,91 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Condition_SimpleCondition_Expression); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_Values); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_Cookie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_BodySource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_StatusDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_StreamMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Response_SimpleResponse); i {
 			case 0:
 				return &v.state
@@ -1473,7 +5807,7 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
-		file_apis_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_apis_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MockAPI_Response_ScriptResponse); i {
 			case 0:
 				return &v.state
@@ -1485,14 +5819,174 @@ func file_apis_proto_init() {
 				return nil
 			}
 		}
+		file_apis_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_WebSocketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_WasmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_ExternalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_StatusDetail_ErrorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_StatusDetail_BadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_StatusDetail_RetryInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_StatusDetail_Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_StatusDetail_BadRequest_FieldViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_WebSocketResponse_Frame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_WebSocketResponse_Close); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_WebSocketResponse_Reply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_apis_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MockAPI_Response_WebSocketResponse_Periodic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_apis_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_apis_proto_msgTypes[34].OneofWrappers = []interface{}{
 		(*MockAPI_Condition_Simple)(nil),
 		(*MockAPI_Condition_Script)(nil),
+		(*MockAPI_Condition_Wasm)(nil),
+		(*MockAPI_Condition_External)(nil),
 	}
-	file_apis_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_apis_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*MockAPI_Response_Simple)(nil),
 		(*MockAPI_Response_Script)(nil),
+		(*MockAPI_Response_Websocket)(nil),
+		(*MockAPI_Response_Wasm)(nil),
+		(*MockAPI_Response_External)(nil),
+	}
+	file_apis_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*MockAPI_Response_BodySource_Base64)(nil),
+		(*MockAPI_Response_BodySource_File)(nil),
+		(*MockAPI_Response_BodySource_Blob)(nil),
+	}
+	file_apis_proto_msgTypes[47].OneofWrappers = []interface{}{
+		(*MockAPI_Response_StatusDetail_ErrorInfo_)(nil),
+		(*MockAPI_Response_StatusDetail_BadRequest_)(nil),
+		(*MockAPI_Response_StatusDetail_RetryInfo_)(nil),
+		(*MockAPI_Response_StatusDetail_Message_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1500,7 +5994,7 @@ func file_apis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_apis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,14 @@ type MockClient interface {
 	SaveMockAPI(ctx context.Context, in *SaveMockAPIRequest, opts ...grpc.CallOption) (*SaveMockAPIResponse, error)
 	DeleteMockAPI(ctx context.Context, in *DeleteMockAPIRequest, opts ...grpc.CallOption) (*DeleteMockAPIResponse, error)
 	ListMockAPI(ctx context.Context, in *ListMockAPIRequest, opts ...grpc.CallOption) (*ListMockAPIResponse, error)
+	ListProtoFiles(ctx context.Context, in *ListProtoFilesRequest, opts ...grpc.CallOption) (*ListProtoFilesResponse, error)
+	ListProtoServices(ctx context.Context, in *ListProtoServicesRequest, opts ...grpc.CallOption) (*ListProtoServicesResponse, error)
+	ListProtoMethods(ctx context.Context, in *ListProtoMethodsRequest, opts ...grpc.CallOption) (*ListProtoMethodsResponse, error)
+	UploadProtoFiles(ctx context.Context, in *UploadProtoFilesRequest, opts ...grpc.CallOption) (*UploadProtoFilesResponse, error)
+	DeleteProtoFiles(ctx context.Context, in *DeleteProtoFilesRequest, opts ...grpc.CallOption) (*DeleteProtoFilesResponse, error)
+	UploadBlob(ctx context.Context, in *UploadBlobRequest, opts ...grpc.CallOption) (*UploadBlobResponse, error)
+	DeleteBlob(ctx context.Context, in *DeleteBlobRequest, opts ...grpc.CallOption) (*DeleteBlobResponse, error)
+	ListBlobs(ctx context.Context, in *ListBlobsRequest, opts ...grpc.CallOption) (*ListBlobsResponse, error)
 }
 
 type mockClient struct {
@@ -57,6 +65,78 @@ func (c *mockClient) ListMockAPI(ctx context.Context, in *ListMockAPIRequest, op
 	return out, nil
 }
 
+func (c *mockClient) ListProtoFiles(ctx context.Context, in *ListProtoFilesRequest, opts ...grpc.CallOption) (*ListProtoFilesResponse, error) {
+	out := new(ListProtoFilesResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/ListProtoFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) ListProtoServices(ctx context.Context, in *ListProtoServicesRequest, opts ...grpc.CallOption) (*ListProtoServicesResponse, error) {
+	out := new(ListProtoServicesResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/ListProtoServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) ListProtoMethods(ctx context.Context, in *ListProtoMethodsRequest, opts ...grpc.CallOption) (*ListProtoMethodsResponse, error) {
+	out := new(ListProtoMethodsResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/ListProtoMethods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) UploadProtoFiles(ctx context.Context, in *UploadProtoFilesRequest, opts ...grpc.CallOption) (*UploadProtoFilesResponse, error) {
+	out := new(UploadProtoFilesResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/UploadProtoFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) DeleteProtoFiles(ctx context.Context, in *DeleteProtoFilesRequest, opts ...grpc.CallOption) (*DeleteProtoFilesResponse, error) {
+	out := new(DeleteProtoFilesResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/DeleteProtoFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) UploadBlob(ctx context.Context, in *UploadBlobRequest, opts ...grpc.CallOption) (*UploadBlobResponse, error) {
+	out := new(UploadBlobResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/UploadBlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) DeleteBlob(ctx context.Context, in *DeleteBlobRequest, opts ...grpc.CallOption) (*DeleteBlobResponse, error) {
+	out := new(DeleteBlobResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/DeleteBlob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockClient) ListBlobs(ctx context.Context, in *ListBlobsRequest, opts ...grpc.CallOption) (*ListBlobsResponse, error) {
+	out := new(ListBlobsResponse)
+	err := c.cc.Invoke(ctx, "/powermock.apis.v1alpha1.Mock/ListBlobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MockServer is the server API for Mock service.
 // All implementations must embed UnimplementedMockServer
 // for forward compatibility
@@ -64,6 +144,14 @@ type MockServer interface {
 	SaveMockAPI(context.Context, *SaveMockAPIRequest) (*SaveMockAPIResponse, error)
 	DeleteMockAPI(context.Context, *DeleteMockAPIRequest) (*DeleteMockAPIResponse, error)
 	ListMockAPI(context.Context, *ListMockAPIRequest) (*ListMockAPIResponse, error)
+	ListProtoFiles(context.Context, *ListProtoFilesRequest) (*ListProtoFilesResponse, error)
+	ListProtoServices(context.Context, *ListProtoServicesRequest) (*ListProtoServicesResponse, error)
+	ListProtoMethods(context.Context, *ListProtoMethodsRequest) (*ListProtoMethodsResponse, error)
+	UploadProtoFiles(context.Context, *UploadProtoFilesRequest) (*UploadProtoFilesResponse, error)
+	DeleteProtoFiles(context.Context, *DeleteProtoFilesRequest) (*DeleteProtoFilesResponse, error)
+	UploadBlob(context.Context, *UploadBlobRequest) (*UploadBlobResponse, error)
+	DeleteBlob(context.Context, *DeleteBlobRequest) (*DeleteBlobResponse, error)
+	ListBlobs(context.Context, *ListBlobsRequest) (*ListBlobsResponse, error)
 	mustEmbedUnimplementedMockServer()
 }
 
@@ -80,6 +168,30 @@ func (*UnimplementedMockServer) DeleteMockAPI(context.Context, *DeleteMockAPIReq
 func (*UnimplementedMockServer) ListMockAPI(context.Context, *ListMockAPIRequest) (*ListMockAPIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMockAPI not implemented")
 }
+func (*UnimplementedMockServer) ListProtoFiles(context.Context, *ListProtoFilesRequest) (*ListProtoFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtoFiles not implemented")
+}
+func (*UnimplementedMockServer) ListProtoServices(context.Context, *ListProtoServicesRequest) (*ListProtoServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtoServices not implemented")
+}
+func (*UnimplementedMockServer) ListProtoMethods(context.Context, *ListProtoMethodsRequest) (*ListProtoMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProtoMethods not implemented")
+}
+func (*UnimplementedMockServer) UploadProtoFiles(context.Context, *UploadProtoFilesRequest) (*UploadProtoFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadProtoFiles not implemented")
+}
+func (*UnimplementedMockServer) DeleteProtoFiles(context.Context, *DeleteProtoFilesRequest) (*DeleteProtoFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProtoFiles not implemented")
+}
+func (*UnimplementedMockServer) UploadBlob(context.Context, *UploadBlobRequest) (*UploadBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadBlob not implemented")
+}
+func (*UnimplementedMockServer) DeleteBlob(context.Context, *DeleteBlobRequest) (*DeleteBlobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlob not implemented")
+}
+func (*UnimplementedMockServer) ListBlobs(context.Context, *ListBlobsRequest) (*ListBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlobs not implemented")
+}
 func (*UnimplementedMockServer) mustEmbedUnimplementedMockServer() {}
 
 func RegisterMockServer(s *grpc.Server, srv MockServer) {
@@ -140,6 +252,150 @@ func _Mock_ListMockAPI_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mock_ListProtoFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProtoFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).ListProtoFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/ListProtoFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).ListProtoFiles(ctx, req.(*ListProtoFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_ListProtoServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProtoServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).ListProtoServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/ListProtoServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).ListProtoServices(ctx, req.(*ListProtoServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_ListProtoMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProtoMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).ListProtoMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/ListProtoMethods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).ListProtoMethods(ctx, req.(*ListProtoMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_UploadProtoFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadProtoFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).UploadProtoFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/UploadProtoFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).UploadProtoFiles(ctx, req.(*UploadProtoFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_DeleteProtoFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProtoFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).DeleteProtoFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/DeleteProtoFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).DeleteProtoFiles(ctx, req.(*DeleteProtoFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_UploadBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).UploadBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/UploadBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).UploadBlob(ctx, req.(*UploadBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_DeleteBlob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).DeleteBlob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/DeleteBlob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).DeleteBlob(ctx, req.(*DeleteBlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mock_ListBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockServer).ListBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powermock.apis.v1alpha1.Mock/ListBlobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockServer).ListBlobs(ctx, req.(*ListBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Mock_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "powermock.apis.v1alpha1.Mock",
 	HandlerType: (*MockServer)(nil),
@@ -156,6 +412,38 @@ var _Mock_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListMockAPI",
 			Handler:    _Mock_ListMockAPI_Handler,
 		},
+		{
+			MethodName: "ListProtoFiles",
+			Handler:    _Mock_ListProtoFiles_Handler,
+		},
+		{
+			MethodName: "ListProtoServices",
+			Handler:    _Mock_ListProtoServices_Handler,
+		},
+		{
+			MethodName: "ListProtoMethods",
+			Handler:    _Mock_ListProtoMethods_Handler,
+		},
+		{
+			MethodName: "UploadProtoFiles",
+			Handler:    _Mock_UploadProtoFiles_Handler,
+		},
+		{
+			MethodName: "DeleteProtoFiles",
+			Handler:    _Mock_DeleteProtoFiles_Handler,
+		},
+		{
+			MethodName: "UploadBlob",
+			Handler:    _Mock_UploadBlob_Handler,
+		},
+		{
+			MethodName: "DeleteBlob",
+			Handler:    _Mock_DeleteBlob_Handler,
+		},
+		{
+			MethodName: "ListBlobs",
+			Handler:    _Mock_ListBlobs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "apis.proto",
@@ -17,12 +17,16 @@ package load
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"io/ioutil"
 
 	yamltool "github.com/ghodss/yaml"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/bilibili-base/powermock/apis/v1alpha1"
 	"github.com/bilibili-base/powermock/pkg/util"
@@ -31,6 +35,13 @@ import (
 
 var (
 	address = "127.0.0.1:30000"
+
+	enableTLS             bool
+	tlsCAFile             string
+	tlsCertFile           string
+	tlsKeyFile            string
+	tlsServerName         string
+	tlsInsecureSkipVerify bool
 )
 
 var log = logger.NewDefault("commandline")
@@ -49,7 +60,11 @@ func CommandLoad() *cobra.Command {
 				"count": len(apis),
 			}, "mock apis loaded from file")
 
-			conn, err := grpc.Dial(address, grpc.WithInsecure())
+			dialOption, err := getTransportDialOption()
+			if err != nil {
+				log.LogFatal(nil, "failed to load tls config: %s", err)
+			}
+			conn, err := grpc.Dial(address, dialOption)
 			if err != nil {
 				log.LogWarn(nil, "please start the mock service through the `powermock serve` command first, "+
 					"and make sure that the correct `address` is specified")
@@ -75,9 +90,43 @@ func CommandLoad() *cobra.Command {
 	}
 	flag := cmd.PersistentFlags()
 	flag.StringVar(&address, "address", address, "the gRPC address of mock server")
+	flag.BoolVar(&enableTLS, "tls", enableTLS, "connect to the mock server with TLS")
+	flag.StringVar(&tlsCAFile, "tls.caFile", tlsCAFile, "CA file to verify the certificate of mock server")
+	flag.StringVar(&tlsCertFile, "tls.certFile", tlsCertFile, "client certificate file for mutual TLS")
+	flag.StringVar(&tlsKeyFile, "tls.keyFile", tlsKeyFile, "client private key file for mutual TLS")
+	flag.StringVar(&tlsServerName, "tls.serverName", tlsServerName, "server name to verify the certificate of mock server")
+	flag.BoolVar(&tlsInsecureSkipVerify, "tls.insecureSkipVerify", tlsInsecureSkipVerify, "skip verifying the certificate of mock server")
 	return cmd
 }
 
+func getTransportDialOption() (grpc.DialOption, error) {
+	if !enableTLS {
+		return grpc.WithInsecure(), nil
+	}
+	config := &tls.Config{
+		ServerName:         tlsServerName,
+		InsecureSkipVerify: tlsInsecureSkipVerify,
+	}
+	if tlsCAFile != "" {
+		data, err := ioutil.ReadFile(tlsCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate found in %s", tlsCAFile)
+		}
+	}
+	if tlsCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(tlsCertFile, tlsKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
 func loadMockAPIs(log logger.Logger, file string) ([]*v1alpha1.MockAPI, error) {
 	data, err := ioutil.ReadFile(file)
 	if err != nil {
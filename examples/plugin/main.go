@@ -0,0 +1,167 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This is an example of external plugin, which matches requests by the header of config,
+// greets in responses and keeps MockAPIs in memory. It is launched by powermock with config.yaml
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+)
+
+type plugin struct {
+	v1alpha1.UnimplementedPluginServer
+
+	lock     sync.Mutex
+	pairs    map[string]string
+	watchers map[chan struct{}]bool
+}
+
+// Describe is used to return the capabilities of plugin
+func (p *plugin) Describe(ctx context.Context, request *v1alpha1.PluginDescribeRequest) (*v1alpha1.PluginDescribeResponse, error) {
+	return &v1alpha1.PluginDescribeResponse{Match: true, Mock: true, Storage: true}, nil
+}
+
+// Match is used to match requests whose header of config.header equals to config.value
+func (p *plugin) Match(ctx context.Context, request *v1alpha1.PluginMatchRequest) (*v1alpha1.PluginMatchResponse, error) {
+	var req struct {
+		Header map[string]string `json:"header"`
+	}
+	if err := json.Unmarshal(request.GetRequest(), &req); err != nil {
+		return nil, err
+	}
+	config := request.GetCondition().GetExternal().GetConfig().GetStructValue().GetFields()
+	header := config["header"].GetStringValue()
+	return &v1alpha1.PluginMatchResponse{
+		Match: req.Header[header] == config["value"].GetStringValue(),
+	}, nil
+}
+
+// MockResponse is used to greet with config.greeting
+func (p *plugin) MockResponse(ctx context.Context, request *v1alpha1.PluginMockResponseRequest) (*v1alpha1.PluginMockResponseResponse, error) {
+	var req struct {
+		Path string `json:"path"`
+	}
+	if err := json.Unmarshal(request.GetRequest(), &req); err != nil {
+		return nil, err
+	}
+	config := request.GetMock().GetExternal().GetConfig().GetStructValue().GetFields()
+	response, err := json.Marshal(map[string]interface{}{
+		"code":   200,
+		"header": map[string]string{"x-powered-by": "external-plugin"},
+		"body": map[string]interface{}{
+			"message": fmt.Sprintf("%s from %s", config["greeting"].GetStringValue(), req.Path),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.PluginMockResponseResponse{Response: response}, nil
+}
+
+// StorageSet is used to set key-val pair
+func (p *plugin) StorageSet(ctx context.Context, request *v1alpha1.PluginStorageSetRequest) (*v1alpha1.PluginStorageSetResponse, error) {
+	p.lock.Lock()
+	p.pairs[request.GetKey()] = request.GetVal()
+	p.lock.Unlock()
+	p.notify()
+	return &v1alpha1.PluginStorageSetResponse{}, nil
+}
+
+// StorageDelete is used to delete specified key
+func (p *plugin) StorageDelete(ctx context.Context, request *v1alpha1.PluginStorageDeleteRequest) (*v1alpha1.PluginStorageDeleteResponse, error) {
+	p.lock.Lock()
+	delete(p.pairs, request.GetKey())
+	p.lock.Unlock()
+	p.notify()
+	return &v1alpha1.PluginStorageDeleteResponse{}, nil
+}
+
+// StorageList is used to list all key-val pairs in storage
+func (p *plugin) StorageList(ctx context.Context, request *v1alpha1.PluginStorageListRequest) (*v1alpha1.PluginStorageListResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	pairs := make(map[string]string, len(p.pairs))
+	for key, val := range p.pairs {
+		pairs[key] = val
+	}
+	return &v1alpha1.PluginStorageListResponse{Pairs: pairs}, nil
+}
+
+// StorageWatch is used to send an event once the watch is established and whenever key-val pairs are changed
+func (p *plugin) StorageWatch(request *v1alpha1.PluginStorageWatchRequest, stream v1alpha1.Plugin_StorageWatchServer) error {
+	events := make(chan struct{}, 1)
+	events <- struct{}{}
+	p.lock.Lock()
+	p.watchers[events] = true
+	p.lock.Unlock()
+	defer func() {
+		p.lock.Lock()
+		delete(p.watchers, events)
+		p.lock.Unlock()
+	}()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-events:
+			if err := stream.Send(&v1alpha1.PluginStorageWatchResponse{}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *plugin) notify() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for events := range p.watchers {
+		select {
+		case events <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func main() {
+	address := os.Getenv("POWERMOCK_PLUGIN_ADDRESS")
+	if address == "" {
+		panic("POWERMOCK_PLUGIN_ADDRESS is required")
+	}
+	listener, err := net.Listen("unix", address)
+	if err != nil {
+		panic(err)
+	}
+	server := grpc.NewServer()
+	v1alpha1.RegisterPluginServer(server, &plugin{
+		pairs:    map[string]string{},
+		watchers: map[chan struct{}]bool{},
+	})
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	fmt.Println("plugin is serving on", address)
+	if err := server.Serve(listener); err != nil {
+		panic(err)
+	}
+}
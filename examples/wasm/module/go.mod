@@ -0,0 +1,3 @@
+module github.com/bilibili-base/powermock/examples/wasm/module
+
+go 1.24
@@ -0,0 +1,103 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build wasip1
+
+// This is an example of WASM module, build it by:
+//   GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o ../modules/greeter.wasm .
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"unsafe"
+)
+
+// buffers keeps the allocated memory alive until the instance is closed
+var buffers [][]byte
+
+//go:wasmimport powermock log
+func hostLog(ptr unsafe.Pointer, size uint32)
+
+//go:wasmexport alloc
+func alloc(size uint32) unsafe.Pointer {
+	buf := make([]byte, size)
+	buffers = append(buffers, buf)
+	return unsafe.Pointer(unsafe.SliceData(buf))
+}
+
+type request struct {
+	Header map[string]string `json:"header"`
+	Path   string            `json:"path"`
+}
+
+type config struct {
+	MinUID   int    `json:"minUid"`
+	Greeting string `json:"greeting"`
+}
+
+//go:wasmexport match
+func match(ptr unsafe.Pointer, size uint32) uint64 {
+	var input struct {
+		Request   request `json:"request"`
+		Condition struct {
+			Wasm struct {
+				Config config `json:"config"`
+			} `json:"wasm"`
+		} `json:"condition"`
+	}
+	if err := json.Unmarshal(unsafe.Slice((*byte)(ptr), size), &input); err != nil {
+		return output(map[string]interface{}{"error": err.Error()})
+	}
+	uid, _ := strconv.Atoi(input.Request.Header["uid"])
+	return output(map[string]interface{}{"match": uid >= input.Condition.Wasm.Config.MinUID})
+}
+
+//go:wasmexport mock
+func mock(ptr unsafe.Pointer, size uint32) uint64 {
+	var input struct {
+		Request request `json:"request"`
+		Mock    struct {
+			Wasm struct {
+				Config config `json:"config"`
+			} `json:"wasm"`
+		} `json:"mock"`
+	}
+	if err := json.Unmarshal(unsafe.Slice((*byte)(ptr), size), &input); err != nil {
+		return output(map[string]interface{}{"error": err.Error()})
+	}
+	log("mocking " + input.Request.Path)
+	return output(map[string]interface{}{
+		"response": map[string]interface{}{
+			"code":   200,
+			"header": map[string]string{"x-powered-by": "wasm"},
+			"body": map[string]interface{}{
+				"message": input.Mock.Wasm.Config.Greeting + ", " + input.Request.Header["uid"],
+			},
+		},
+	})
+}
+
+// output is used to encode v and return the pointer and size of it
+func output(v interface{}) uint64 {
+	data, _ := json.Marshal(v)
+	buffers = append(buffers, data)
+	return uint64(uintptr(unsafe.Pointer(unsafe.SliceData(data))))<<32 | uint64(len(data))
+}
+
+func log(message string) {
+	hostLog(unsafe.Pointer(unsafe.StringData(message)), uint32(len(message)))
+}
+
+func main() {}
@@ -0,0 +1,95 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apimanager
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/util"
+)
+
+// blobKeyPrefix is the storage key prefix of uploaded blobs
+const blobKeyPrefix = "__blob__/"
+
+// UploadBlob is used to upload or replace a blob
+func (s *Manager) UploadBlob(ctx context.Context, request *v1alpha1.UploadBlobRequest) (*v1alpha1.UploadBlobResponse, error) {
+	blob := request.GetData()
+	if blob.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name of blob is required")
+	}
+	var encoder jsonpb.Marshaler
+	data, err := encoder.MarshalToString(blob)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.storage.Set(ctx, blobKeyPrefix+blob.GetName(), data); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.UploadBlobResponse{}, nil
+}
+
+// DeleteBlob is used to delete the specified blob
+func (s *Manager) DeleteBlob(ctx context.Context, request *v1alpha1.DeleteBlobRequest) (*v1alpha1.DeleteBlobResponse, error) {
+	if err := s.storage.Delete(ctx, blobKeyPrefix+request.GetName()); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.DeleteBlobResponse{}, nil
+}
+
+// ListBlobs is used to list the uploaded blobs without their data
+func (s *Manager) ListBlobs(ctx context.Context, request *v1alpha1.ListBlobsRequest) (*v1alpha1.ListBlobsResponse, error) {
+	s.lock.RLock()
+	blobs := s.blobs
+	s.lock.RUnlock()
+	keywords := request.GetKeywords()
+	var data []*v1alpha1.BlobInfo
+	for name, blob := range blobs {
+		if keywords != "" && !strings.Contains(name, keywords) {
+			continue
+		}
+		data = append(data, &v1alpha1.BlobInfo{
+			Name:        name,
+			Size:        uint64(len(blob.GetData())),
+			ContentType: blob.GetContentType(),
+		})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Name < data[j].Name
+	})
+	total := uint64(len(data))
+	pagination := util.GetPagination(request.GetPagination())
+	if err := util.PaginateSlice(pagination, &data); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.ListBlobsResponse{
+		Data:       data,
+		Pagination: &v1alpha1.ListResponse{Total: total},
+	}, nil
+}
+
+// GetBlob is used to get the uploaded blob by name
+func (s *Manager) GetBlob(name string) (*v1alpha1.Blob, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	blob, ok := s.blobs[name]
+	return blob, ok
+}
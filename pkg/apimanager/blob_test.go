@@ -0,0 +1,86 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apimanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+)
+
+func TestUploadBlob(t *testing.T) {
+	manager := newTestManager(t)
+	ctx := context.TODO()
+	tests := []struct {
+		name    string
+		blob    *v1alpha1.Blob
+		invalid bool
+	}{
+		{name: "image", blob: &v1alpha1.Blob{Name: "images/a.png", Data: []byte("png"), ContentType: "image/png"}},
+		{name: "without content type", blob: &v1alpha1.Blob{Name: "b.bin", Data: []byte{0, 1, 2, 3}}},
+		{name: "replace", blob: &v1alpha1.Blob{Name: "b.bin", Data: []byte{4, 5}}},
+		{name: "without name", blob: &v1alpha1.Blob{Data: []byte("data")}, invalid: true},
+	}
+	for _, test := range tests {
+		_, err := manager.UploadBlob(ctx, &v1alpha1.UploadBlobRequest{Data: test.blob})
+		if test.invalid {
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		// uploaded blobs are loaded from storage
+		assert.Nil(t, manager.loadAPIs(ctx), test.name)
+		blob, ok := manager.GetBlob(test.blob.GetName())
+		assert.True(t, ok, test.name)
+		assert.Equal(t, test.blob.GetData(), blob.GetData(), test.name)
+		assert.Equal(t, test.blob.GetContentType(), blob.GetContentType(), test.name)
+	}
+
+	listTests := []struct {
+		keywords string
+		expect   []*v1alpha1.BlobInfo
+	}{
+		{
+			expect: []*v1alpha1.BlobInfo{
+				{Name: "b.bin", Size: 2},
+				{Name: "images/a.png", Size: 3, ContentType: "image/png"},
+			},
+		},
+		{
+			keywords: "images/",
+			expect:   []*v1alpha1.BlobInfo{{Name: "images/a.png", Size: 3, ContentType: "image/png"}},
+		},
+		{keywords: "unknown"},
+	}
+	for _, test := range listTests {
+		resp, err := manager.ListBlobs(ctx, &v1alpha1.ListBlobsRequest{Keywords: test.keywords})
+		assert.Nil(t, err, test.keywords)
+		assert.Equal(t, test.expect, resp.GetData(), test.keywords)
+		assert.Equal(t, uint64(len(test.expect)), resp.GetPagination().GetTotal(), test.keywords)
+	}
+
+	_, err := manager.DeleteBlob(ctx, &v1alpha1.DeleteBlobRequest{Name: "b.bin"})
+	assert.Nil(t, err)
+	assert.Nil(t, manager.loadAPIs(ctx))
+	_, ok := manager.GetBlob("b.bin")
+	assert.False(t, ok)
+	_, ok = manager.GetBlob("images/a.png")
+	assert.True(t, ok)
+}
@@ -0,0 +1,391 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apimanager
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+	"github.com/bilibili-base/powermock/pkg/util"
+)
+
+// protoSourceKeyPrefix is the storage key prefix of uploaded proto files
+const protoSourceKeyPrefix = "__proto__/"
+
+// SetProtoManager is used to set the proto manager used by proto related APIs
+func (s *Manager) SetProtoManager(protoManager protomanager.Provider) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.protoManager = protoManager
+}
+
+// ListProtoFiles is used to list the loaded proto files and load errors
+func (s *Manager) ListProtoFiles(ctx context.Context, request *v1alpha1.ListProtoFilesRequest) (*v1alpha1.ListProtoFilesResponse, error) {
+	protoManager, err := s.getProtoManager()
+	if err != nil {
+		return nil, err
+	}
+	keywords := request.GetKeywords()
+	var files []*v1alpha1.ProtoFile
+	for _, file := range protoManager.ListFiles() {
+		if keywords != "" && !strings.Contains(file.Path, keywords) {
+			continue
+		}
+		files = append(files, getProtoFile(file))
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	total := uint64(len(files))
+	pagination := util.GetPagination(request.GetPagination())
+	if err := util.PaginateSlice(pagination, &files); err != nil {
+		return nil, err
+	}
+
+	var loadErrors []*v1alpha1.ProtoLoadError
+	for _, loadError := range protoManager.ListLoadErrors() {
+		loadErrors = append(loadErrors, &v1alpha1.ProtoLoadError{
+			File:    loadError.Path,
+			Message: loadError.Error,
+		})
+	}
+	return &v1alpha1.ListProtoFilesResponse{
+		Data:       files,
+		Errors:     loadErrors,
+		Pagination: &v1alpha1.ListResponse{Total: total},
+	}, nil
+}
+
+// ListProtoServices is used to list the loaded gRPC services
+func (s *Manager) ListProtoServices(ctx context.Context, request *v1alpha1.ListProtoServicesRequest) (*v1alpha1.ListProtoServicesResponse, error) {
+	protoManager, err := s.getProtoManager()
+	if err != nil {
+		return nil, err
+	}
+	keywords := request.GetKeywords()
+	var services []*v1alpha1.ProtoService
+	for _, file := range protoManager.ListFiles() {
+		for _, service := range file.Descriptor.GetServices() {
+			if keywords != "" && !strings.Contains(service.GetFullyQualifiedName(), keywords) {
+				continue
+			}
+			var methods []string
+			for _, method := range service.GetMethods() {
+				methods = append(methods, method.GetName())
+			}
+			services = append(services, &v1alpha1.ProtoService{
+				Name:       service.GetFullyQualifiedName(),
+				Methods:    methods,
+				Comment:    getComment(service),
+				File:       file.Path,
+				Repository: file.Repository,
+			})
+		}
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+	total := uint64(len(services))
+	pagination := util.GetPagination(request.GetPagination())
+	if err := util.PaginateSlice(pagination, &services); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.ListProtoServicesResponse{
+		Data:       services,
+		Pagination: &v1alpha1.ListResponse{Total: total},
+	}, nil
+}
+
+// ListProtoMethods is used to list the loaded gRPC methods with the schemas of input and output
+func (s *Manager) ListProtoMethods(ctx context.Context, request *v1alpha1.ListProtoMethodsRequest) (*v1alpha1.ListProtoMethodsResponse, error) {
+	protoManager, err := s.getProtoManager()
+	if err != nil {
+		return nil, err
+	}
+	keywords := request.GetKeywords()
+	var methods []*v1alpha1.ProtoMethod
+	for _, file := range protoManager.ListFiles() {
+		for _, service := range file.Descriptor.GetServices() {
+			if request.GetService() != "" && request.GetService() != service.GetFullyQualifiedName() {
+				continue
+			}
+			for _, method := range service.GetMethods() {
+				path := protomanager.GetPathByFullyQualifiedName(method.GetFullyQualifiedName())
+				if keywords != "" && !strings.Contains(path, keywords) {
+					continue
+				}
+				methods = append(methods, getProtoMethod(file, method))
+			}
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Path < methods[j].Path
+	})
+	total := uint64(len(methods))
+	pagination := util.GetPagination(request.GetPagination())
+	if err := util.PaginateSlice(pagination, &methods); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.ListProtoMethodsResponse{
+		Data:       methods,
+		Pagination: &v1alpha1.ListResponse{Total: total},
+	}, nil
+}
+
+// UploadProtoFiles is used to upload proto files, they are persisted in storage and loaded without restart
+func (s *Manager) UploadProtoFiles(ctx context.Context, request *v1alpha1.UploadProtoFilesRequest) (*v1alpha1.UploadProtoFilesResponse, error) {
+	protoManager, err := s.getProtoManager()
+	if err != nil {
+		return nil, err
+	}
+	sources := request.GetFiles()
+	if data := request.GetFileDescriptorSet(); len(data) > 0 {
+		var set descriptor.FileDescriptorSet
+		if err := proto.Unmarshal(data, &set); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal fileDescriptorSet: %s", err)
+		}
+		for _, fd := range set.GetFile() {
+			data, err := proto.Marshal(fd)
+			if err != nil {
+				return nil, err
+			}
+			sources = append(sources, &v1alpha1.ProtoSource{
+				Name:        fd.GetName(),
+				Descriptor_: data,
+			})
+		}
+	}
+	if len(sources) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no proto file is provided")
+	}
+
+	// validate the uploaded files together with the existing ones
+	uploaded := map[string]bool{}
+	for _, source := range sources {
+		if source.GetName() == "" {
+			return nil, status.Error(codes.InvalidArgument, "name of proto file is required")
+		}
+		uploaded[source.GetName()] = true
+	}
+	s.lock.RLock()
+	merged := append([]*v1alpha1.ProtoSource{}, sources...)
+	for name, source := range s.protoSources {
+		if !uploaded[name] {
+			merged = append(merged, source)
+		}
+	}
+	s.lock.RUnlock()
+	fds, err := protoManager.ParseProtoSources(merged)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse proto files: %s", err)
+	}
+
+	var encoder jsonpb.Marshaler
+	for _, source := range sources {
+		data, err := encoder.MarshalToString(source)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.storage.Set(ctx, protoSourceKeyPrefix+source.GetName(), data); err != nil {
+			return nil, err
+		}
+	}
+
+	var methods []string
+	for _, fd := range fds {
+		if !uploaded[fd.GetName()] {
+			continue
+		}
+		for _, service := range fd.GetServices() {
+			for _, method := range service.GetMethods() {
+				methods = append(methods, protomanager.GetPathByFullyQualifiedName(method.GetFullyQualifiedName()))
+			}
+		}
+	}
+	return &v1alpha1.UploadProtoFilesResponse{
+		Methods: methods,
+	}, nil
+}
+
+// DeleteProtoFiles is used to delete the uploaded proto files
+func (s *Manager) DeleteProtoFiles(ctx context.Context, request *v1alpha1.DeleteProtoFilesRequest) (*v1alpha1.DeleteProtoFilesResponse, error) {
+	for _, name := range request.GetNames() {
+		if err := s.storage.Delete(ctx, protoSourceKeyPrefix+name); err != nil {
+			return nil, err
+		}
+	}
+	return &v1alpha1.DeleteProtoFilesResponse{}, nil
+}
+
+// loadProtoSources is used to load the uploaded proto sources into proto manager if they have been changed
+func (s *Manager) loadProtoSources(sources map[string]*v1alpha1.ProtoSource) error {
+	s.lock.Lock()
+	changed := len(sources) != len(s.protoSources)
+	for name, source := range sources {
+		if previous, ok := s.protoSources[name]; !ok || !proto.Equal(previous, source) {
+			changed = true
+		}
+	}
+	s.protoSources = sources
+	protoManager := s.protoManager
+	s.lock.Unlock()
+	if !changed || protoManager == nil {
+		return nil
+	}
+	list := make([]*v1alpha1.ProtoSource, 0, len(sources))
+	for _, source := range sources {
+		list = append(list, source)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].GetName() < list[j].GetName()
+	})
+	s.LogInfo(nil, "load uploaded proto files, total %d", len(list))
+	return protoManager.SetProtoSources(list)
+}
+
+func (s *Manager) getProtoManager() (protomanager.Provider, error) {
+	s.lock.RLock()
+	protoManager := s.protoManager
+	s.lock.RUnlock()
+	if protoManager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "proto manager is not enabled")
+	}
+	return protoManager, nil
+}
+
+func getProtoFile(file *protomanager.FileInfo) *v1alpha1.ProtoFile {
+	fd := file.Descriptor
+	var services, dependencies []string
+	for _, service := range fd.GetServices() {
+		services = append(services, service.GetFullyQualifiedName())
+	}
+	for _, dependency := range fd.GetDependencies() {
+		dependencies = append(dependencies, dependency.GetName())
+	}
+	return &v1alpha1.ProtoFile{
+		Name:         file.Path,
+		Package:      fd.GetPackage(),
+		Services:     services,
+		Dependencies: dependencies,
+		Repository:   file.Repository,
+		Uploaded:     file.Uploaded,
+	}
+}
+
+func getProtoMethod(file *protomanager.FileInfo, method *desc.MethodDescriptor) *v1alpha1.ProtoMethod {
+	// collect all message types referenced by input and output
+	var dependencies []*v1alpha1.ProtoMessage
+	visited := map[string]bool{
+		method.GetInputType().GetFullyQualifiedName():  true,
+		method.GetOutputType().GetFullyQualifiedName(): true,
+	}
+	queue := []*desc.MessageDescriptor{method.GetInputType(), method.GetOutputType()}
+	for len(queue) > 0 {
+		message := queue[0]
+		queue = queue[1:]
+		for _, field := range message.GetFields() {
+			fieldType := field.GetMessageType()
+			if fieldType == nil || visited[fieldType.GetFullyQualifiedName()] {
+				continue
+			}
+			visited[fieldType.GetFullyQualifiedName()] = true
+			queue = append(queue, fieldType)
+			dependencies = append(dependencies, getProtoMessage(fieldType))
+		}
+	}
+	return &v1alpha1.ProtoMethod{
+		Name:            method.GetName(),
+		Path:            protomanager.GetPathByFullyQualifiedName(method.GetFullyQualifiedName()),
+		Service:         method.GetService().GetFullyQualifiedName(),
+		ClientStreaming: method.IsClientStreaming(),
+		ServerStreaming: method.IsServerStreaming(),
+		InputType:       getProtoMessage(method.GetInputType()),
+		OutputType:      getProtoMessage(method.GetOutputType()),
+		Dependencies:    dependencies,
+		Comment:         getComment(method),
+		File:            file.Path,
+		Repository:      file.Repository,
+	}
+}
+
+func getProtoMessage(message *desc.MessageDescriptor) *v1alpha1.ProtoMessage {
+	fields := make([]*v1alpha1.ProtoField, 0, len(message.GetFields()))
+	for _, field := range message.GetFields() {
+		fields = append(fields, getProtoField(field))
+	}
+	return &v1alpha1.ProtoMessage{
+		Name:    message.GetFullyQualifiedName(),
+		Fields:  fields,
+		Comment: getComment(message),
+	}
+}
+
+func getProtoField(field *desc.FieldDescriptor) *v1alpha1.ProtoField {
+	var typeName string
+	fieldType := strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+	switch {
+	case field.GetMessageType() != nil:
+		fieldType = "message"
+		typeName = field.GetMessageType().GetFullyQualifiedName()
+	case field.GetEnumType() != nil:
+		fieldType = "enum"
+		typeName = field.GetEnumType().GetFullyQualifiedName()
+	}
+	label := "optional"
+	switch field.GetLabel() {
+	case descriptor.FieldDescriptorProto_LABEL_REPEATED:
+		label = "repeated"
+	case descriptor.FieldDescriptorProto_LABEL_REQUIRED:
+		label = "required"
+	}
+	var oneof string
+	if field.GetOneOf() != nil {
+		oneof = field.GetOneOf().GetName()
+	}
+	return &v1alpha1.ProtoField{
+		Name:     field.GetName(),
+		JsonName: field.GetJSONName(),
+		Number:   field.GetNumber(),
+		Type:     fieldType,
+		TypeName: typeName,
+		Label:    label,
+		IsMap:    field.IsMap(),
+		Oneof:    oneof,
+		Comment:  getComment(field),
+	}
+}
+
+// getComment is used to get the comments of descriptor, which requires IncludeSourceCodeInfo
+func getComment(d desc.Descriptor) string {
+	info := d.GetSourceInfo()
+	if info == nil {
+		return ""
+	}
+	comment := info.GetLeadingComments()
+	if comment == "" {
+		comment = info.GetTrailingComments()
+	}
+	return strings.TrimSpace(comment)
+}
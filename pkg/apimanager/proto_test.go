@@ -0,0 +1,307 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apimanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+const testProto = `syntax = "proto3";
+
+package test;
+
+// Greeter greets people
+service Greeter {
+  // Hello says hello
+  rpc Hello(HelloRequest) returns (HelloReply);
+  rpc Chat(stream HelloRequest) returns (stream HelloReply);
+}
+
+service Counter {
+  rpc Count(HelloRequest) returns (HelloReply);
+}
+
+message HelloRequest {
+  string name = 1;
+  repeated Tag tags = 2;
+}
+
+message HelloReply {
+  string message = 1;
+  map<string, Tag> tags = 2;
+}
+
+message Tag {
+  string key = 1;
+  Kind kind = 2;
+}
+
+enum Kind {
+  KIND_UNKNOWN = 0;
+}
+`
+
+func newTestProtoManager(t *testing.T, files map[string]string) *Manager {
+	dir := t.TempDir()
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	cfg := protomanager.NewConfig()
+	cfg.ProtoDir = dir
+	cfg.ProtoImportPaths = nil
+	protoManager, err := protomanager.New(cfg, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	manager := newTestManager(t)
+	manager.SetProtoManager(protoManager)
+	return manager
+}
+
+func TestListProtoFiles(t *testing.T) {
+	manager := newTestProtoManager(t, map[string]string{
+		"test.proto":   testProto,
+		"broken.proto": "syntax = ",
+	})
+	tests := []struct {
+		keywords string
+		expect   []string
+	}{
+		{keywords: "", expect: []string{"test.proto"}},
+		{keywords: "test", expect: []string{"test.proto"}},
+		{keywords: "other", expect: nil},
+	}
+	for _, test := range tests {
+		resp, err := manager.ListProtoFiles(context.TODO(), &v1alpha1.ListProtoFilesRequest{Keywords: test.keywords})
+		assert.Nil(t, err, test.keywords)
+		var names []string
+		for _, file := range resp.GetData() {
+			names = append(names, file.GetName())
+		}
+		assert.Equal(t, test.expect, names, test.keywords)
+		assert.Len(t, resp.GetErrors(), 1, test.keywords)
+		assert.Equal(t, "broken.proto", resp.GetErrors()[0].GetFile(), test.keywords)
+	}
+
+	resp, err := manager.ListProtoFiles(context.TODO(), &v1alpha1.ListProtoFilesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, "test", resp.GetData()[0].GetPackage())
+	assert.Equal(t, []string{"test.Greeter", "test.Counter"}, resp.GetData()[0].GetServices())
+}
+
+func TestListProtoServices(t *testing.T) {
+	manager := newTestProtoManager(t, map[string]string{"test.proto": testProto})
+	tests := []struct {
+		keywords   string
+		pagination *v1alpha1.ListOptions
+		expect     []string
+		total      uint64
+	}{
+		{keywords: "", expect: []string{"test.Counter", "test.Greeter"}, total: 2},
+		{keywords: "Greeter", expect: []string{"test.Greeter"}, total: 1},
+		{keywords: "", pagination: &v1alpha1.ListOptions{Page: 2, Limit: 1}, expect: []string{"test.Greeter"}, total: 2},
+		{keywords: "Unknown", expect: nil, total: 0},
+	}
+	for _, test := range tests {
+		resp, err := manager.ListProtoServices(context.TODO(), &v1alpha1.ListProtoServicesRequest{
+			Keywords:   test.keywords,
+			Pagination: test.pagination,
+		})
+		assert.Nil(t, err, test.keywords)
+		var names []string
+		for _, service := range resp.GetData() {
+			names = append(names, service.GetName())
+		}
+		assert.Equal(t, test.expect, names, test.keywords)
+		assert.Equal(t, test.total, resp.GetPagination().GetTotal(), test.keywords)
+	}
+
+	resp, err := manager.ListProtoServices(context.TODO(), &v1alpha1.ListProtoServicesRequest{Keywords: "Greeter"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Hello", "Chat"}, resp.GetData()[0].GetMethods())
+	assert.Equal(t, "Greeter greets people", resp.GetData()[0].GetComment())
+	assert.Equal(t, "test.proto", resp.GetData()[0].GetFile())
+}
+
+func TestListProtoMethods(t *testing.T) {
+	manager := newTestProtoManager(t, map[string]string{"test.proto": testProto})
+	tests := []struct {
+		service  string
+		keywords string
+		expect   []string
+	}{
+		{expect: []string{"/test.Counter/Count", "/test.Greeter/Chat", "/test.Greeter/Hello"}},
+		{service: "test.Greeter", expect: []string{"/test.Greeter/Chat", "/test.Greeter/Hello"}},
+		{service: "test.Greeter", keywords: "Hello", expect: []string{"/test.Greeter/Hello"}},
+		{service: "test.Unknown", expect: nil},
+	}
+	for _, test := range tests {
+		resp, err := manager.ListProtoMethods(context.TODO(), &v1alpha1.ListProtoMethodsRequest{
+			Service:  test.service,
+			Keywords: test.keywords,
+		})
+		assert.Nil(t, err, test.service)
+		var paths []string
+		for _, method := range resp.GetData() {
+			paths = append(paths, method.GetPath())
+		}
+		assert.Equal(t, test.expect, paths, test.service)
+	}
+
+	resp, err := manager.ListProtoMethods(context.TODO(), &v1alpha1.ListProtoMethodsRequest{Keywords: "Chat"})
+	assert.Nil(t, err)
+	method := resp.GetData()[0]
+	assert.True(t, method.GetClientStreaming())
+	assert.True(t, method.GetServerStreaming())
+	assert.Equal(t, "test.HelloRequest", method.GetInputType().GetName())
+	assert.Equal(t, "test.HelloReply", method.GetOutputType().GetName())
+
+	resp, err = manager.ListProtoMethods(context.TODO(), &v1alpha1.ListProtoMethodsRequest{Keywords: "Hello"})
+	assert.Nil(t, err)
+	method = resp.GetData()[0]
+	assert.Equal(t, "Hello says hello", method.GetComment())
+	var dependencies []string
+	for _, message := range method.GetDependencies() {
+		dependencies = append(dependencies, message.GetName())
+	}
+	assert.Contains(t, dependencies, "test.Tag")
+
+	fields := map[string]*v1alpha1.ProtoField{}
+	for _, field := range method.GetInputType().GetFields() {
+		fields[field.GetName()] = field
+	}
+	assert.Equal(t, "string", fields["name"].GetType())
+	assert.Equal(t, "message", fields["tags"].GetType())
+	assert.Equal(t, "test.Tag", fields["tags"].GetTypeName())
+	assert.Equal(t, "repeated", fields["tags"].GetLabel())
+}
+
+func TestListProtoWithoutProtoManager(t *testing.T) {
+	manager := newTestManager(t)
+	_, err := manager.ListProtoFiles(context.TODO(), &v1alpha1.ListProtoFilesRequest{})
+	assert.NotNil(t, err)
+	_, err = manager.ListProtoServices(context.TODO(), &v1alpha1.ListProtoServicesRequest{})
+	assert.NotNil(t, err)
+	_, err = manager.ListProtoMethods(context.TODO(), &v1alpha1.ListProtoMethodsRequest{})
+	assert.NotNil(t, err)
+}
+
+func TestUploadProtoFiles(t *testing.T) {
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"set.proto": `syntax = "proto3"; package set; service Set { rpc Get(Empty) returns (Empty); } message Empty {}`,
+		}),
+	}
+	fds, err := parser.ParseFiles("set.proto")
+	assert.Nil(t, err)
+	fileDescriptorSet, err := proto.Marshal(&descriptor.FileDescriptorSet{File: []*descriptor.FileDescriptorProto{fds[0].AsFileDescriptorProto()}})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name    string
+		request *v1alpha1.UploadProtoFilesRequest
+		methods []string
+		invalid bool
+	}{
+		{
+			name: "content",
+			request: &v1alpha1.UploadProtoFilesRequest{Files: []*v1alpha1.ProtoSource{
+				{Name: "uploaded/test.proto", Content: testProto},
+			}},
+			methods: []string{"/test.Greeter/Hello", "/test.Greeter/Chat", "/test.Counter/Count"},
+		},
+		{
+			name: "import uploaded file",
+			request: &v1alpha1.UploadProtoFilesRequest{Files: []*v1alpha1.ProtoSource{
+				{Name: "uploaded/echo.proto", Content: `syntax = "proto3"; package echo; import "uploaded/test.proto";
+service Echo { rpc Echo(test.HelloRequest) returns (test.HelloReply); }`},
+			}},
+			methods: []string{"/echo.Echo/Echo"},
+		},
+		{
+			name:    "fileDescriptorSet",
+			request: &v1alpha1.UploadProtoFilesRequest{FileDescriptorSet: fileDescriptorSet},
+			methods: []string{"/set.Set/Get"},
+		},
+		{
+			name:    "no file",
+			request: &v1alpha1.UploadProtoFilesRequest{},
+			invalid: true,
+		},
+		{
+			name: "no name",
+			request: &v1alpha1.UploadProtoFilesRequest{Files: []*v1alpha1.ProtoSource{
+				{Content: testProto},
+			}},
+			invalid: true,
+		},
+		{
+			name: "syntax error",
+			request: &v1alpha1.UploadProtoFilesRequest{Files: []*v1alpha1.ProtoSource{
+				{Name: "uploaded/broken.proto", Content: "syntax = "},
+			}},
+			invalid: true,
+		},
+		{
+			name:    "invalid fileDescriptorSet",
+			request: &v1alpha1.UploadProtoFilesRequest{FileDescriptorSet: []byte("invalid")},
+			invalid: true,
+		},
+	}
+	manager := newTestProtoManager(t, nil)
+	for _, test := range tests {
+		resp, err := manager.UploadProtoFiles(context.TODO(), test.request)
+		assert.Equal(t, codes.InvalidArgument == status.Code(err), test.invalid, test.name)
+		if test.invalid {
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.methods, resp.GetMethods(), test.name)
+		// uploaded files are loaded from storage, so that the following files can import them
+		assert.Nil(t, manager.loadAPIs(context.TODO()), test.name)
+		for _, method := range test.methods {
+			_, ok := manager.protoManager.GetMethod(method)
+			assert.True(t, ok, method)
+		}
+	}
+
+	resp, err := manager.ListProtoFiles(context.TODO(), &v1alpha1.ListProtoFilesRequest{Keywords: "uploaded/"})
+	assert.Nil(t, err)
+	assert.Len(t, resp.GetData(), 2)
+	for _, file := range resp.GetData() {
+		assert.True(t, file.GetUploaded(), file.GetName())
+	}
+
+	_, err = manager.DeleteProtoFiles(context.TODO(), &v1alpha1.DeleteProtoFilesRequest{Names: []string{"set.proto"}})
+	assert.Nil(t, err)
+	assert.Nil(t, manager.loadAPIs(context.TODO()))
+	_, ok := manager.protoManager.GetMethod("/set.Set/Get")
+	assert.False(t, ok)
+	_, ok = manager.protoManager.GetMethod("/test.Greeter/Hello")
+	assert.True(t, ok)
+}
@@ -32,14 +32,17 @@ import (
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	"github.com/bilibili-base/powermock/apis/v1alpha1"
 	"github.com/bilibili-base/powermock/pkg/interact"
 	"github.com/bilibili-base/powermock/pkg/pluginregistry"
 	"github.com/bilibili-base/powermock/pkg/pluginregistry/storage/memory"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
 	"github.com/bilibili-base/powermock/pkg/util"
 	"github.com/bilibili-base/powermock/pkg/util/logger"
+	"github.com/bilibili-base/powermock/pkg/util/tlsconfig"
 )
 
 // Provider defines the APIManager interface
@@ -47,6 +50,11 @@ import (
 type Provider interface {
 	v1alpha1.MockServer
 	MockResponse(ctx context.Context, request *interact.Request) (*interact.Response, error)
+	MatchCase(ctx context.Context, request *interact.Request) (*v1alpha1.MockAPI_Case, error)
+	MatchCondition(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (bool, error)
+	GenerateResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request) (*interact.Response, error)
+	GetBlob(name string) (*v1alpha1.Blob, bool)
+	SetProtoManager(protoManager protomanager.Provider)
 	Start(ctx context.Context, cancelFunc context.CancelFunc) error
 }
 
@@ -63,8 +71,18 @@ type Manager struct {
 	// map[uniqueKey]*v1alpha1.MockAPI
 	// readonly
 	apis map[string]*v1alpha1.MockAPI
-	// used to protect the pointer of mux, apis
+	// used to protect the pointer of mux, apis, protoManager
 	lock sync.RWMutex
+	// optional, it is nil when the gRPC mock server is disabled
+	protoManager protomanager.Provider
+	// map[name]*v1alpha1.ProtoSource
+	// readonly
+	protoSources map[string]*v1alpha1.ProtoSource
+	// map[name]*v1alpha1.Blob
+	// readonly
+	blobs map[string]*v1alpha1.Blob
+	// optional, it is nil when TLS is disabled
+	tlsManager *tlsconfig.Manager
 
 	v1alpha1.UnimplementedMockServer
 	registerer prometheus.Registerer
@@ -75,13 +93,18 @@ type Manager struct {
 type Config struct {
 	GRPCAddress string
 	HTTPAddress string
+	// MaxMessageSize is the max size of messages in bytes, such as uploaded blobs and WASM modules
+	MaxMessageSize int
+	TLS            *tlsconfig.Config
 }
 
 // NewConfig is used to init config with default values
 func NewConfig() *Config {
 	return &Config{
-		GRPCAddress: "0.0.0.0:30000",
-		HTTPAddress: "0.0.0.0:30001",
+		GRPCAddress:    "0.0.0.0:30000",
+		HTTPAddress:    "0.0.0.0:30001",
+		MaxMessageSize: 64 << 20,
+		TLS:            tlsconfig.NewConfig(),
 	}
 }
 
@@ -89,6 +112,8 @@ func NewConfig() *Config {
 func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
 	f.StringVar(&c.GRPCAddress, prefix+"apiManager.grpcAddress", c.GRPCAddress, "gRPC service listener address")
 	f.StringVar(&c.HTTPAddress, prefix+"apiManager.httpAddress", c.HTTPAddress, "http service listener address")
+	f.IntVar(&c.MaxMessageSize, prefix+"apiManager.maxMessageSize", c.MaxMessageSize, "max size of messages in bytes, such as uploaded blobs")
+	c.TLS.RegisterFlagsWithPrefix(prefix+"apiManager.", f)
 }
 
 // Validate is used to validate config and returns error on failure
@@ -96,7 +121,10 @@ func (c *Config) Validate() error {
 	if c.HTTPAddress == "" && c.GRPCAddress == "" {
 		return errors.New("[apiManager] grpcAddress and httpAddress cannot be empty at the same time")
 	}
-	return nil
+	if c.MaxMessageSize <= 0 {
+		return errors.New("[apiManager] maxMessageSize should be positive")
+	}
+	return c.TLS.Validate()
 }
 
 // New is used to init service
@@ -122,6 +150,9 @@ func (s *Manager) Start(ctx context.Context, cancelFunc context.CancelFunc) erro
 	if err := s.loadAPIs(ctx); err != nil {
 		return err
 	}
+	if err := s.setupTLS(); err != nil {
+		return err
+	}
 	if err := s.setupGRPCServer(ctx, cancelFunc); err != nil {
 		return err
 	}
@@ -138,6 +169,12 @@ func (s *Manager) SaveMockAPI(ctx context.Context, request *v1alpha1.SaveMockAPI
 	if api == nil {
 		return nil, errors.New("api is nil")
 	}
+	if isReservedKey(api.GetUniqueKey()) {
+		return nil, status.Errorf(codes.InvalidArgument, "uniqueKey(%s) is reserved", api.GetUniqueKey())
+	}
+	if err := s.validateMockAPI(api); err != nil {
+		return nil, err
+	}
 	var encoder jsonpb.Marshaler
 	data, err := encoder.MarshalToString(api)
 	if err != nil {
@@ -152,6 +189,9 @@ func (s *Manager) SaveMockAPI(ctx context.Context, request *v1alpha1.SaveMockAPI
 // DeleteMockAPI is used to delete MockAPI
 func (s *Manager) DeleteMockAPI(ctx context.Context, request *v1alpha1.DeleteMockAPIRequest) (*v1alpha1.DeleteMockAPIResponse, error) {
 	uniqueKey := request.GetUniqueKey()
+	if isReservedKey(uniqueKey) {
+		return nil, status.Errorf(codes.InvalidArgument, "uniqueKey(%s) is reserved", uniqueKey)
+	}
 	if err := s.storage.Delete(ctx, uniqueKey); err != nil {
 		return nil, err
 	}
@@ -195,8 +235,8 @@ func (s *Manager) ListMockAPI(ctx context.Context, request *v1alpha1.ListMockAPI
 	}, nil
 }
 
-// MatchAPI is used to match MockAPI
-func (s *Manager) MatchAPI(host, path, method string) (*v1alpha1.MockAPI, bool) {
+// MatchAPI is used to match MockAPI, the named variables of path are returned as well
+func (s *Manager) MatchAPI(host, path, method string) (*v1alpha1.MockAPI, map[string]string, bool) {
 	s.lock.RLock()
 	m := s.mux
 	apis := s.apis
@@ -209,29 +249,145 @@ func (s *Manager) MatchAPI(host, path, method string) (*v1alpha1.MockAPI, bool)
 		Host:   host,
 	}, &match)
 	if !matched {
-		return nil, false
+		return nil, nil, false
 	}
 
 	api := apis[match.Route.GetName()]
 	if api != nil {
-		return api, true
+		return api, match.Vars, true
 	}
-	return nil, false
+	return nil, nil, false
 }
 
 // MockResponse is used to mock response
 func (s *Manager) MockResponse(ctx context.Context, request *interact.Request) (*interact.Response, error) {
-	api, ok := s.MatchAPI(request.Host, request.Path, request.Method)
+	mockCase, err := s.MatchCase(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return s.GenerateResponse(ctx, mockCase.GetResponse(), request)
+}
+
+// MatchCase is used to match the MockAPI of request and return the first matched case
+// The named variables of path are set to the Params of request
+func (s *Manager) MatchCase(ctx context.Context, request *interact.Request) (*v1alpha1.MockAPI_Case, error) {
+	api, params, ok := s.MatchAPI(request.Host, request.Path, request.Method)
 	if !ok {
 		return nil, fmt.Errorf("unable to find mock config of %s", request.Path)
 	}
-	mockCase, err := s.getMatchedCase(ctx, request, api)
-	if err != nil {
-		return nil, err
+	request.Params = params
+	return s.getMatchedCase(ctx, request, api)
+}
+
+// MatchCondition is used to determine whether request satisfies the condition by match plugins
+// An empty condition matches any request
+func (s *Manager) MatchCondition(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (bool, error) {
+	if condition == nil {
+		return true, nil
+	}
+	for _, plugin := range s.pluginRegistry.MatchPlugins() {
+		matched, err := plugin.Match(ctx, request, condition)
+		if err != nil {
+			return false, newPluginError(codes.Internal, plugin.Name(), err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validateMockAPI is used to validate the conditions and responses of MockAPI by the plugins which implement
+// ConditionValidator and ResponseValidator, and check whether the languages of scripts are supported
+func (s *Manager) validateMockAPI(api *v1alpha1.MockAPI) error {
+	languages := s.getScriptLanguages()
+	validateCondition := func(name string, condition *v1alpha1.MockAPI_Condition) error {
+		if condition == nil {
+			return nil
+		}
+		if script := condition.GetScript(); script != nil && !languages[script.GetLang()] {
+			return status.Errorf(codes.InvalidArgument, "%s: unsupported script language %q, supported languages: %v",
+				name, script.GetLang(), sortedKeys(languages))
+		}
+		for _, plugin := range s.pluginRegistry.MatchPlugins() {
+			validator, ok := plugin.(pluginregistry.ConditionValidator)
+			if !ok {
+				continue
+			}
+			if err := validator.ValidateCondition(condition); err != nil {
+				return newPluginError(codes.InvalidArgument, plugin.Name(), fmt.Errorf("%s: %s", name, err))
+			}
+		}
+		return nil
+	}
+	validateResponse := func(name string, response *v1alpha1.MockAPI_Response) error {
+		if response == nil {
+			return nil
+		}
+		if script := response.GetScript(); script != nil && !languages[script.GetLang()] {
+			return status.Errorf(codes.InvalidArgument, "%s: unsupported script language %q, supported languages: %v",
+				name, script.GetLang(), sortedKeys(languages))
+		}
+		for _, plugin := range s.pluginRegistry.MockPlugins() {
+			validator, ok := plugin.(pluginregistry.ResponseValidator)
+			if !ok {
+				continue
+			}
+			if err := validator.ValidateResponse(response); err != nil {
+				return newPluginError(codes.InvalidArgument, plugin.Name(), fmt.Errorf("%s: %s", name, err))
+			}
+		}
+		return nil
+	}
+	for i, mockCase := range api.GetCases() {
+		if err := validateCondition(fmt.Sprintf("case %d", i), mockCase.GetCondition()); err != nil {
+			return err
+		}
+		if err := validateResponse(fmt.Sprintf("case %d", i), mockCase.GetResponse()); err != nil {
+			return err
+		}
+		for j, reply := range mockCase.GetResponse().GetWebsocket().GetReplies() {
+			if err := validateCondition(fmt.Sprintf("case %d reply %d", i, j), reply.GetCondition()); err != nil {
+				return err
+			}
+		}
 	}
+	return nil
+}
+
+// getScriptLanguages is used to collect the script languages handled by the installed plugins
+func (s *Manager) getScriptLanguages() map[string]bool {
+	languages := map[string]bool{}
+	add := func(plugin pluginregistry.Plugin) {
+		if provider, ok := plugin.(pluginregistry.ScriptLanguageProvider); ok {
+			for _, lang := range provider.ScriptLanguages() {
+				languages[lang] = true
+			}
+		}
+	}
+	for _, plugin := range s.pluginRegistry.MatchPlugins() {
+		add(plugin)
+	}
+	for _, plugin := range s.pluginRegistry.MockPlugins() {
+		add(plugin)
+	}
+	return languages
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GenerateResponse is used to generate response of request according to MockAPI_Response by mock plugins
+func (s *Manager) GenerateResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request) (*interact.Response, error) {
 	response := interact.NewDefaultResponse(request)
 	for _, plugin := range s.pluginRegistry.MockPlugins() {
-		abort, err := plugin.MockResponse(ctx, mockCase.GetResponse(), request, response)
+		abort, err := plugin.MockResponse(ctx, mock, request, response)
 		if err != nil {
 			return nil, newPluginError(codes.Internal, plugin.Name(), err)
 		}
@@ -256,6 +412,18 @@ func (s *Manager) setupStorage() error {
 	return nil
 }
 
+func (s *Manager) setupTLS() error {
+	if !s.cfg.TLS.IsEnabled() {
+		return nil
+	}
+	tlsManager, err := tlsconfig.New(s.cfg.TLS, s.Logger)
+	if err != nil {
+		return err
+	}
+	s.tlsManager = tlsManager
+	return nil
+}
+
 func (s *Manager) setupHTTPServer(ctx context.Context, cancelFunc func()) error {
 	addr := s.cfg.HTTPAddress
 	if addr == "" {
@@ -263,7 +431,16 @@ func (s *Manager) setupHTTPServer(ctx context.Context, cancelFunc func()) error
 	}
 	s.LogInfo(nil, "starting api manager on http address: %s", addr)
 	serverMux := runtime.NewServeMux()
-	err := v1alpha1.RegisterMockHandlerFromEndpoint(context.TODO(), serverMux, s.cfg.GRPCAddress, []grpc.DialOption{grpc.WithInsecure()})
+	// serve the self-signed CAs of auto-generated certificates for clients to trust
+	err := serverMux.HandlePath(http.MethodGet, "/tls/ca.pem", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		certificates := tlsconfig.CACertificates()
+		if len(certificates) == 0 {
+			http.Error(w, "no self-signed CA is in use", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-pem-file")
+		_, _ = w.Write(certificates)
+	})
 	if err != nil {
 		return err
 	}
@@ -271,7 +448,28 @@ func (s *Manager) setupHTTPServer(ctx context.Context, cancelFunc func()) error
 		Addr:    s.cfg.HTTPAddress,
 		Handler: serverMux,
 	}
+	if s.tlsManager != nil {
+		// the gRPC listener is served with TLS too, so the gateway calls the service in process
+		if err := v1alpha1.RegisterMockHandlerServer(context.TODO(), serverMux, s); err != nil {
+			return err
+		}
+		server.TLSConfig = s.tlsManager.TLSConfig("h2", "http/1.1")
+	} else {
+		err := v1alpha1.RegisterMockHandlerFromEndpoint(context.TODO(), serverMux, s.cfg.GRPCAddress, []grpc.DialOption{
+			grpc.WithInsecure(),
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallRecvMsgSize(s.cfg.MaxMessageSize),
+				grpc.MaxCallSendMsgSize(s.cfg.MaxMessageSize),
+			),
+		})
+		if err != nil {
+			return err
+		}
+	}
 	util.StartServiceAsync(ctx, cancelFunc, s.Logger.NewLogger("http"), func() error {
+		if server.TLSConfig != nil {
+			return server.ListenAndServeTLS("", "")
+		}
 		return server.ListenAndServe()
 	}, func() error {
 		return server.Shutdown(context.TODO())
@@ -289,7 +487,15 @@ func (s *Manager) setupGRPCServer(ctx context.Context, cancelFunc func()) error
 	if err != nil {
 		return err
 	}
-	server := grpc.NewServer(grpc.UnaryInterceptor(util.GRPCLoggingMiddleware(s.Logger)))
+	options := []grpc.ServerOption{
+		grpc.UnaryInterceptor(util.GRPCLoggingMiddleware(s.Logger)),
+		grpc.MaxRecvMsgSize(s.cfg.MaxMessageSize),
+		grpc.MaxSendMsgSize(s.cfg.MaxMessageSize),
+	}
+	if s.tlsManager != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(s.tlsManager.TLSConfig("h2"))))
+	}
+	server := grpc.NewServer(options...)
 	v1alpha1.RegisterMockServer(server, s)
 	util.StartServiceAsync(ctx, cancelFunc, s.Logger.NewLogger("gRPC"), func() error {
 		return server.Serve(listener)
@@ -329,11 +535,32 @@ func (s *Manager) loadAPIs(ctx context.Context) error {
 		return err
 	}
 	apis := map[string]*v1alpha1.MockAPI{}
+	protoSources := map[string]*v1alpha1.ProtoSource{}
+	blobs := map[string]*v1alpha1.Blob{}
 	s.LogInfo(nil, "load apis from storage, total %d", len(pairs))
 	for key, val := range pairs {
+		if strings.HasPrefix(key, protoSourceKeyPrefix) {
+			var source v1alpha1.ProtoSource
+			if err := jsonpb.UnmarshalString(val, &source); err != nil {
+				s.LogError(map[string]interface{}{"key": key}, "failed to load proto file, skip it: %s", err)
+				continue
+			}
+			protoSources[source.GetName()] = &source
+			continue
+		}
+		if strings.HasPrefix(key, blobKeyPrefix) {
+			var blob v1alpha1.Blob
+			if err := jsonpb.UnmarshalString(val, &blob); err != nil {
+				s.LogError(map[string]interface{}{"key": key}, "failed to load blob, skip it: %s", err)
+				continue
+			}
+			blobs[blob.GetName()] = &blob
+			continue
+		}
 		var api v1alpha1.MockAPI
 		if err := jsonpb.UnmarshalString(val, &api); err != nil {
-			return fmt.Errorf("failed to load(%s): %s", key, err)
+			s.LogError(map[string]interface{}{"uniqueKey": key}, "failed to load api, skip it: %s", err)
+			continue
 		}
 		apis[key] = &api
 		s.LogInfo(map[string]interface{}{
@@ -344,24 +571,35 @@ func (s *Manager) loadAPIs(ctx context.Context) error {
 	s.lock.Lock()
 	s.apis = apis
 	s.mux = buildMux(apis, s.Logger)
+	s.blobs = blobs
 	s.lock.Unlock()
+	if err := s.loadProtoSources(protoSources); err != nil {
+		s.LogError(nil, "failed to load uploaded proto files: %s", err)
+	}
 	return nil
 }
 
+// reservedKeyPrefixes are the storage key prefixes of data other than MockAPIs
+var reservedKeyPrefixes = []string{protoSourceKeyPrefix, blobKeyPrefix}
+
+// isReservedKey is used to determine whether the key is reserved and can not be used as the uniqueKey of MockAPI
+func isReservedKey(key string) bool {
+	for _, prefix := range reservedKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Manager) getMatchedCase(ctx context.Context, request *interact.Request, api *v1alpha1.MockAPI) (*v1alpha1.MockAPI_Case, error) {
 	for _, mockCase := range api.Cases {
-		for _, plugin := range s.pluginRegistry.MatchPlugins() {
-			condition := mockCase.GetCondition()
-			if condition == nil {
-				return mockCase, nil
-			}
-			matched, err := plugin.Match(ctx, request, condition)
-			if err != nil {
-				return nil, newPluginError(codes.Internal, plugin.Name(), err)
-			}
-			if matched {
-				return mockCase, nil
-			}
+		matched, err := s.MatchCondition(ctx, request, mockCase.GetCondition())
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return mockCase, nil
 		}
 	}
 	return nil, status.Error(codes.NotFound, "no case matched")
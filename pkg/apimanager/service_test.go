@@ -0,0 +1,254 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apimanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// scriptPlugin is a plugin which handles the scripts of javascript
+type scriptPlugin struct{}
+
+func (scriptPlugin) Name() string { return "script" }
+
+func (scriptPlugin) ScriptLanguages() []string { return []string{"javascript"} }
+
+func (scriptPlugin) Match(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (bool, error) {
+	return false, nil
+}
+
+func (scriptPlugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response,
+	request *interact.Request, response *interact.Response) (bool, error) {
+	return false, nil
+}
+
+func newTestManager(t *testing.T) *Manager {
+	log, err := logger.New(logger.NewConfig(), "test", prometheus.NewRegistry())
+	assert.Nil(t, err)
+	registry, err := pluginregistry.New(pluginregistry.NewConfig(), log, prometheus.NewRegistry())
+	assert.Nil(t, err)
+	assert.Nil(t, registry.RegisterMatchPlugins(scriptPlugin{}))
+	assert.Nil(t, registry.RegisterMockPlugins(scriptPlugin{}))
+	provider, err := New(NewConfig(), registry, log, prometheus.NewRegistry())
+	assert.Nil(t, err)
+	manager := provider.(*Manager)
+	assert.Nil(t, manager.setupStorage())
+	return manager
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       func(cfg *Config)
+		expectErr bool
+	}{
+		{name: "default", cfg: func(cfg *Config) {}},
+		{name: "grpc only", cfg: func(cfg *Config) { cfg.HTTPAddress = "" }},
+		{name: "without addresses", cfg: func(cfg *Config) { cfg.HTTPAddress, cfg.GRPCAddress = "", "" }, expectErr: true},
+		{name: "max message size", cfg: func(cfg *Config) { cfg.MaxMessageSize = 0 }, expectErr: true},
+	}
+	for _, test := range tests {
+		cfg := NewConfig()
+		test.cfg(cfg)
+		assert.Equal(t, test.expectErr, cfg.Validate() != nil, test.name)
+	}
+}
+
+func TestSaveMockAPIWithReservedKey(t *testing.T) {
+	manager := newTestManager(t)
+	tests := []struct {
+		uniqueKey string
+		reserved  bool
+	}{
+		{uniqueKey: "hello", reserved: false},
+		{uniqueKey: "hello/__proto__/a", reserved: false},
+		{uniqueKey: protoSourceKeyPrefix + "a.proto", reserved: true},
+		{uniqueKey: blobKeyPrefix + "a.png", reserved: true},
+	}
+	for _, test := range tests {
+		_, err := manager.SaveMockAPI(context.TODO(), &v1alpha1.SaveMockAPIRequest{
+			Data: &v1alpha1.MockAPI{UniqueKey: test.uniqueKey, Path: "/hello"},
+		})
+		assert.Equal(t, test.reserved, err != nil, test.uniqueKey)
+		_, err = manager.DeleteMockAPI(context.TODO(), &v1alpha1.DeleteMockAPIRequest{UniqueKey: test.uniqueKey})
+		assert.Equal(t, test.reserved, err != nil, test.uniqueKey)
+	}
+}
+
+func TestLoadAPIsSkipsInvalidEntries(t *testing.T) {
+	manager := newTestManager(t)
+	ctx := context.TODO()
+	assert.Nil(t, manager.storage.Set(ctx, "valid", `{"uniqueKey": "valid", "path": "/valid"}`))
+	assert.Nil(t, manager.storage.Set(ctx, "invalid", `{"uniqueKey": `))
+	assert.Nil(t, manager.storage.Set(ctx, protoSourceKeyPrefix+"invalid.proto", `not json`))
+	assert.Nil(t, manager.storage.Set(ctx, blobKeyPrefix+"invalid.png", `not json`))
+	assert.Nil(t, manager.storage.Set(ctx, blobKeyPrefix+"valid.png", `{"name": "valid.png", "data": "aGVsbG8="}`))
+	assert.Nil(t, manager.loadAPIs(ctx))
+
+	resp, err := manager.ListMockAPI(ctx, &v1alpha1.ListMockAPIRequest{})
+	assert.Nil(t, err)
+	assert.Len(t, resp.GetData(), 1)
+	assert.Equal(t, "valid", resp.GetData()[0].GetUniqueKey())
+	blob, ok := manager.GetBlob("valid.png")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("hello"), blob.GetData())
+}
+
+func TestSaveMockAPIWithScriptLanguage(t *testing.T) {
+	manager := newTestManager(t)
+	condition := func(lang string) *v1alpha1.MockAPI_Condition {
+		return &v1alpha1.MockAPI_Condition{
+			Condition: &v1alpha1.MockAPI_Condition_Script{
+				Script: &v1alpha1.MockAPI_Condition_ScriptCondition{Lang: lang, Content: "true"},
+			},
+		}
+	}
+	response := func(lang string) *v1alpha1.MockAPI_Response {
+		return &v1alpha1.MockAPI_Response{
+			Response: &v1alpha1.MockAPI_Response_Script{
+				Script: &v1alpha1.MockAPI_Response_ScriptResponse{Lang: lang, Content: "({})"},
+			},
+		}
+	}
+	websocket := func(lang string) *v1alpha1.MockAPI_Response {
+		return &v1alpha1.MockAPI_Response{
+			Response: &v1alpha1.MockAPI_Response_Websocket{
+				Websocket: &v1alpha1.MockAPI_Response_WebSocketResponse{
+					Replies: []*v1alpha1.MockAPI_Response_WebSocketResponse_Reply{{Condition: condition(lang)}},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		mock    *v1alpha1.MockAPI_Case
+		wantErr bool
+	}{
+		{name: "supported", mock: &v1alpha1.MockAPI_Case{Condition: condition("javascript"), Response: response("javascript")}},
+		{name: "condition", mock: &v1alpha1.MockAPI_Case{Condition: condition("python")}, wantErr: true},
+		{name: "empty condition", mock: &v1alpha1.MockAPI_Case{Condition: condition("")}, wantErr: true},
+		{name: "response", mock: &v1alpha1.MockAPI_Case{Response: response("python")}, wantErr: true},
+		{name: "websocket reply", mock: &v1alpha1.MockAPI_Case{Response: websocket("python")}, wantErr: true},
+	}
+	for _, test := range tests {
+		_, err := manager.SaveMockAPI(context.TODO(), &v1alpha1.SaveMockAPIRequest{
+			Data: &v1alpha1.MockAPI{UniqueKey: test.name, Path: "/hello", Cases: []*v1alpha1.MockAPI_Case{test.mock}},
+		})
+		assert.Equal(t, test.wantErr, err != nil, "%s: %v", test.name, err)
+	}
+}
+
+// validatorPlugin is a match plugin which rejects the simple conditions with the operator "invalid"
+type validatorPlugin struct{}
+
+func (validatorPlugin) Name() string { return "validator" }
+
+func (validatorPlugin) Match(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (bool, error) {
+	return false, nil
+}
+
+func (validatorPlugin) ValidateCondition(condition *v1alpha1.MockAPI_Condition) error {
+	for _, item := range condition.GetSimple().GetItems() {
+		if item.GetOperator() == "invalid" {
+			return errors.New("invalid operator")
+		}
+	}
+	return nil
+}
+
+func TestSaveMockAPIWithInvalidCondition(t *testing.T) {
+	manager := newTestManager(t)
+	assert.Nil(t, manager.pluginRegistry.RegisterMatchPlugins(validatorPlugin{}))
+	condition := func(operator string) *v1alpha1.MockAPI_Condition {
+		return &v1alpha1.MockAPI_Condition{
+			Condition: &v1alpha1.MockAPI_Condition_Simple{
+				Simple: &v1alpha1.MockAPI_Condition_SimpleCondition{
+					Items: []*v1alpha1.MockAPI_Condition_SimpleCondition_Item{{Operator: operator}},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		mock    *v1alpha1.MockAPI_Case
+		wantErr bool
+	}{
+		{name: "valid", mock: &v1alpha1.MockAPI_Case{Condition: condition("==")}},
+		{name: "without condition", mock: &v1alpha1.MockAPI_Case{}},
+		{name: "invalid", mock: &v1alpha1.MockAPI_Case{Condition: condition("invalid")}, wantErr: true},
+		{
+			name: "invalid websocket reply",
+			mock: &v1alpha1.MockAPI_Case{Response: &v1alpha1.MockAPI_Response{
+				Response: &v1alpha1.MockAPI_Response_Websocket{
+					Websocket: &v1alpha1.MockAPI_Response_WebSocketResponse{
+						Replies: []*v1alpha1.MockAPI_Response_WebSocketResponse_Reply{{Condition: condition("invalid")}},
+					},
+				},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		_, err := manager.SaveMockAPI(context.TODO(), &v1alpha1.SaveMockAPIRequest{
+			Data: &v1alpha1.MockAPI{UniqueKey: test.name, Path: "/hello", Cases: []*v1alpha1.MockAPI_Case{test.mock}},
+		})
+		if test.wantErr {
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), test.name)
+			assert.Contains(t, status.Convert(err).Message(), "plugin(validator)", test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+	}
+}
+
+func TestMatchCaseWithParams(t *testing.T) {
+	manager := newTestManager(t)
+	ctx := context.TODO()
+	for _, path := range []string{"/users/{id}", "/users/{id}/posts/{post:[0-9]+}", "/hello"} {
+		_, err := manager.SaveMockAPI(ctx, &v1alpha1.SaveMockAPIRequest{
+			Data: &v1alpha1.MockAPI{UniqueKey: path, Path: path, Cases: []*v1alpha1.MockAPI_Case{{}}},
+		})
+		assert.Nil(t, err, path)
+	}
+	assert.Nil(t, manager.loadAPIs(ctx))
+	tests := []struct {
+		path   string
+		params map[string]string
+		found  bool
+	}{
+		{path: "/users/1", params: map[string]string{"id": "1"}, found: true},
+		{path: "/users/1/posts/2", params: map[string]string{"id": "1", "post": "2"}, found: true},
+		{path: "/users/1/posts/a", found: false},
+		{path: "/hello", params: map[string]string{}, found: true},
+	}
+	for _, test := range tests {
+		request := &interact.Request{Method: "GET", Path: test.path}
+		_, err := manager.MatchCase(ctx, request)
+		assert.Equal(t, test.found, err == nil, test.path)
+		assert.Equal(t, test.params, request.Params, test.path)
+	}
+}
@@ -33,7 +33,7 @@ type Config struct {
 	HTTPMockServer *httpmockserver.Config
 	ApiManager     *apimanager.Config
 	PluginRegistry *pluginregistry.Config
-	Plugin         *PluginConfig
+	Plugin         pluginregistry.PluginConfigs
 }
 
 // NewConfig is used to init config with default values
@@ -45,7 +45,7 @@ func NewConfig() *Config {
 		HTTPMockServer: httpmockserver.NewConfig(),
 		ApiManager:     apimanager.NewConfig(),
 		PluginRegistry: pluginregistry.NewConfig(),
-		Plugin:         NewPluginConfig(),
+		Plugin:         pluginregistry.NewPluginConfigs(),
 	}
 }
 
@@ -14,53 +14,16 @@
 
 package generic
 
+// The built-in plugins are registered by the init functions of their packages,
+// other plugins can be added by importing their packages which call pluginregistry.RegisterFactory
 import (
-	"github.com/spf13/pflag"
-
-	pluginsgrpc "github.com/bilibili-base/powermock/pkg/pluginregistry/grpc"
-	pluginshttp "github.com/bilibili-base/powermock/pkg/pluginregistry/http"
-	pluginssimple "github.com/bilibili-base/powermock/pkg/pluginregistry/simple"
-	pluginredis "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/redis"
-	pluginrediscluster "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/rediscluster"
-	"github.com/bilibili-base/powermock/pkg/util"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/cel"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/external"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/grpc"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/http"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/script"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/simple"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/redis"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/rediscluster"
+	_ "github.com/bilibili-base/powermock/pkg/pluginregistry/wasm"
 )
-
-// PluginConfig defines the plugin config
-type PluginConfig struct {
-	Redis        *pluginredis.Config
-	RedisCluster *pluginrediscluster.Config
-	Simple       *pluginssimple.Config
-	GRPC         *pluginsgrpc.Config
-	HTTP         *pluginshttp.Config
-}
-
-// NewPluginConfig is used to create plugin config
-func NewPluginConfig() *PluginConfig {
-	return &PluginConfig{
-		Redis:        pluginredis.NewConfig(),
-		RedisCluster: pluginrediscluster.NewConfig(),
-		Simple:       pluginssimple.NewConfig(),
-		GRPC:         pluginsgrpc.NewConfig(),
-		HTTP:         pluginshttp.NewConfig(),
-	}
-}
-
-// RegisterFlagsWithPrefix is used to register flags
-func (c *PluginConfig) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
-	c.Redis.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.RedisCluster.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.Simple.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.GRPC.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.HTTP.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-}
-
-// Validate is used to validate config and returns error on failure
-func (c *PluginConfig) Validate() error {
-	return util.ValidateConfigs(
-		c.Redis,
-		c.RedisCluster,
-		c.Simple,
-		c.GRPC,
-		c.HTTP,
-	)
-}
@@ -23,11 +23,6 @@ import (
 	grpcmockserver "github.com/bilibili-base/powermock/pkg/mockserver/grpc"
 	httpmockserver "github.com/bilibili-base/powermock/pkg/mockserver/http"
 	"github.com/bilibili-base/powermock/pkg/pluginregistry"
-	pluginsgrpc "github.com/bilibili-base/powermock/pkg/pluginregistry/grpc"
-	pluginshttp "github.com/bilibili-base/powermock/pkg/pluginregistry/http"
-	pluginssimple "github.com/bilibili-base/powermock/pkg/pluginregistry/simple"
-	pluginredis "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/redis"
-	pluginrediscluster "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/rediscluster"
 	"github.com/bilibili-base/powermock/pkg/util"
 	"github.com/bilibili-base/powermock/pkg/util/logger"
 )
@@ -52,11 +47,9 @@ func Startup(
 	}
 
 	var (
-		mockPlugins    []pluginregistry.MockPlugin
-		matchPlugins   []pluginregistry.MatchPlugin
-		storagePlugin  pluginregistry.StoragePlugin
 		httpMockServer httpmockserver.Provider
 		gRPCMockServer grpcmockserver.Provider
+		deps           = &pluginregistry.Dependencies{GetBlob: apiManager.GetBlob}
 	)
 
 	if cfg.HTTPMockServer.IsEnabled() {
@@ -73,36 +66,6 @@ func Startup(
 		httpMockServer = server
 	}
 
-	if cfg.Plugin.Redis.IsEnabled() {
-		log.LogInfo(nil, "* start to create plugin(redis)")
-		plugin, err := pluginredis.New(cfg.Plugin.Redis, log, registerer)
-		if err != nil {
-			log.LogFatal(nil, "failed to create storage plugin(redis): %s", err)
-			return err
-		}
-		storagePlugin = plugin
-	}
-
-	if storagePlugin == nil && cfg.Plugin.RedisCluster.IsEnabled() {
-		log.LogInfo(nil, "* start to create plugin(redisCluster)")
-		plugin, err := pluginrediscluster.New(cfg.Plugin.RedisCluster, log, registerer)
-		if err != nil {
-			log.LogFatal(nil, "failed to create storage plugin(redisCluster): %s", err)
-			return err
-		}
-		storagePlugin = plugin
-	}
-
-	if cfg.Plugin.Simple.IsEnabled() {
-		log.LogInfo(nil, "* start to create plugin(simple)")
-		simplePlugin, err := pluginssimple.New(cfg.Plugin.Simple, log, registerer)
-		if err != nil {
-			return err
-		}
-		mockPlugins = append(mockPlugins, simplePlugin)
-		matchPlugins = append(matchPlugins, simplePlugin)
-	}
-
 	if cfg.GRPCMockServer.IsEnabled() {
 		log.LogInfo(nil, "* start to create grpcMockServer")
 		server, err := grpcmockserver.New(
@@ -115,33 +78,15 @@ func Startup(
 			log.LogFatal(nil, "failed to create gRPCMockServer:", err)
 		}
 		gRPCMockServer = server
-		if cfg.Plugin.GRPC.IsEnabled() {
-			log.LogInfo(nil, "* start to create plugin(gRPC)")
-			grpcPlugin, err := pluginsgrpc.New(cfg.Plugin.GRPC, server.GetProtoManager().GetMethod, log, registerer)
-			if err != nil {
-				return err
-			}
-			mockPlugins = append(mockPlugins, grpcPlugin)
+		apiManager.SetProtoManager(server.GetProtoManager())
+		if httpMockServer != nil {
+			httpMockServer.SetProtoManager(server.GetProtoManager())
 		}
-	}
-
-	if cfg.Plugin.HTTP.IsEnabled() {
-		log.LogInfo(nil, "* start to create plugin(http)")
-		httpPlugin, err := pluginshttp.New(cfg.Plugin.HTTP, log, registerer)
-		if err != nil {
-			return err
-		}
-		mockPlugins = append(mockPlugins, httpPlugin)
+		deps.ProtoManager = server.GetProtoManager()
 	}
 
 	log.LogInfo(nil, "* start to install plugins")
-	if err := pluginRegistry.RegisterMockPlugins(mockPlugins...); err != nil {
-		return err
-	}
-	if err := pluginRegistry.RegisterMatchPlugins(matchPlugins...); err != nil {
-		return err
-	}
-	if err := pluginRegistry.RegisterStoragePlugin(storagePlugin); err != nil {
+	if err := pluginRegistry.Install(cfg.Plugin, deps); err != nil {
 		return err
 	}
 
@@ -151,11 +96,9 @@ func Startup(
 		return err
 	}
 
-	if storagePlugin != nil {
-		log.LogInfo(nil, "* start to start storage plugin")
-		if err := storagePlugin.Start(ctx, cancelFunc); err != nil {
-			return err
-		}
+	log.LogInfo(nil, "* start to start plugins")
+	if err := pluginRegistry.Start(ctx, cancelFunc); err != nil {
+		return err
 	}
 
 	log.LogInfo(nil, "* start to start apiManager")
@@ -15,58 +15,13 @@
 package bootstrap
 
 import (
-	"github.com/spf13/pflag"
-
-	"github.com/bilibili-base/powermock/pkg/apimanager"
-	grpcmockserver "github.com/bilibili-base/powermock/pkg/mockserver/grpc"
-	httpmockserver "github.com/bilibili-base/powermock/pkg/mockserver/http"
-	"github.com/bilibili-base/powermock/pkg/pluginregistry"
-	"github.com/bilibili-base/powermock/pkg/util"
-	"github.com/bilibili-base/powermock/pkg/util/logger"
+	"github.com/bilibili-base/powermock/pkg/bootstraps/generic"
 )
 
-// Config defines the powermock config with plugins
-type Config struct {
-	MetricsAddress string
-	Log            *logger.Config
-	GRPCMockServer *grpcmockserver.Config
-	HTTPMockServer *httpmockserver.Config
-	ApiManager     *apimanager.Config
-	PluginRegistry *pluginregistry.Config
-	Plugin         *PluginConfig
-}
+// Config defines the powermock config with plugins, which is the same as the generic one
+type Config = generic.Config
 
 // NewConfig is used to init config with default values
 func NewConfig() *Config {
-	return &Config{
-		MetricsAddress: "0.0.0.0:8081",
-		Log:            logger.NewConfig(),
-		GRPCMockServer: grpcmockserver.NewConfig(),
-		HTTPMockServer: httpmockserver.NewConfig(),
-		ApiManager:     apimanager.NewConfig(),
-		PluginRegistry: pluginregistry.NewConfig(),
-		Plugin:         NewPluginConfig(),
-	}
-}
-
-// RegisterFlagsWithPrefix is used to register flags
-func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
-	f.StringVar(&c.MetricsAddress, prefix+"metrics.address", c.MetricsAddress, "metrics address")
-	c.Log.RegisterFlagsWithPrefix(prefix, f)
-	c.HTTPMockServer.RegisterFlagsWithPrefix(prefix, f)
-	c.GRPCMockServer.RegisterFlagsWithPrefix(prefix, f)
-	c.ApiManager.RegisterFlagsWithPrefix(prefix, f)
-	c.PluginRegistry.RegisterFlagsWithPrefix(prefix, f)
-	c.Plugin.RegisterFlagsWithPrefix(prefix, f)
-}
-
-// Validate is used to validate config and returns error on failure
-func (c *Config) Validate() error {
-	return util.ValidateConfigs(
-		c.PluginRegistry,
-		c.GRPCMockServer,
-		c.HTTPMockServer,
-		c.ApiManager,
-		c.Plugin,
-	)
+	return generic.NewConfig()
 }
@@ -15,57 +15,24 @@
 package bootstrap
 
 import (
-	"github.com/spf13/pflag"
+	"github.com/prometheus/client_golang/prometheus"
 
-	pluginsgrpc "github.com/bilibili-base/powermock/pkg/pluginregistry/grpc"
-	pluginshttp "github.com/bilibili-base/powermock/pkg/pluginregistry/http"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry"
 	pluginscript "github.com/bilibili-base/powermock/pkg/pluginregistry/script"
-	pluginssimple "github.com/bilibili-base/powermock/pkg/pluginregistry/simple"
-	pluginredis "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/redis"
-	pluginrediscluster "github.com/bilibili-base/powermock/pkg/pluginregistry/storage/rediscluster"
-	"github.com/bilibili-base/powermock/pkg/util"
+	scriptcore "github.com/bilibili-base/powermock/pkg/pluginregistry/script/core"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
 )
 
-// PluginConfig defines the plugin config
-type PluginConfig struct {
-	Redis        *pluginredis.Config
-	RedisCluster *pluginrediscluster.Config
-	Simple       *pluginssimple.Config
-	GRPC         *pluginsgrpc.Config
-	HTTP         *pluginshttp.Config
-	Script       *pluginscript.Config
-}
-
-// NewPluginConfig is used to create plugin config
-func NewPluginConfig() *PluginConfig {
-	return &PluginConfig{
-		Redis:        pluginredis.NewConfig(),
-		RedisCluster: pluginrediscluster.NewConfig(),
-		Simple:       pluginssimple.NewConfig(),
-		GRPC:         pluginsgrpc.NewConfig(),
-		HTTP:         pluginshttp.NewConfig(),
-		Script:       pluginscript.NewConfig(),
-	}
-}
-
-// RegisterFlagsWithPrefix is used to register flags
-func (c *PluginConfig) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
-	c.Redis.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.RedisCluster.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.Simple.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.GRPC.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.HTTP.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-	c.Script.RegisterFlagsWithPrefix(prefix+"plugin.", f)
-}
-
-// Validate is used to validate config and returns error on failure
-func (c *PluginConfig) Validate() error {
-	return util.ValidateConfigs(
-		c.Redis,
-		c.RedisCluster,
-		c.Simple,
-		c.GRPC,
-		c.HTTP,
-		c.Script,
-	)
+func init() {
+	// v8 is used by default for compatibility, goja is available as well
+	pluginregistry.Register("script", func() *pluginscript.Config {
+		cfg := pluginscript.NewConfig()
+		cfg.Engine = pluginscript.EngineV8
+		return cfg
+	}, func(cfg *pluginscript.Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*pluginscript.Plugin, error) {
+		return pluginscript.New(cfg, map[string]pluginscript.Engine{
+			pluginscript.EngineV8: scriptcore.Engine{},
+		}, logger, registerer)
+	})
 }
@@ -15,7 +15,11 @@
 package interact
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
+	"net"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 )
@@ -25,10 +29,19 @@ type Protocol string
 
 // defines a set of known protocols
 const (
-	ProtocolHTTP Protocol = "HTTP"
-	ProtocolGRPC Protocol = "GRPC"
+	ProtocolHTTP      Protocol = "HTTP"
+	ProtocolGRPC      Protocol = "GRPC"
+	ProtocolGRPCWeb   Protocol = "GRPC_WEB"
+	ProtocolConnect   Protocol = "CONNECT"
+	ProtocolWebSocket Protocol = "WEBSOCKET"
 )
 
+// IsGRPC returns whether the protocol carries gRPC messages,
+// such as gRPC, gRPC-Web and Connect
+func (p Protocol) IsGRPC() bool {
+	return p == ProtocolGRPC || p == ProtocolGRPCWeb || p == ProtocolConnect
+}
+
 // Message defines a generic message interface
 type Message interface {
 	proto.Message
@@ -40,35 +53,185 @@ type Message interface {
 
 // Request defines the request structure
 type Request struct {
-	Protocol Protocol          `json:"protocol"`
-	Method   string            `json:"method"`
-	Host     string            `json:"host"`
-	Path     string            `json:"path"`
-	Header   map[string]string `json:"header"`
-	Body     Message           `json:"body"`
+	Protocol Protocol `json:"protocol"`
+	Method   string   `json:"method"`
+	Host     string   `json:"host"`
+	Path     string   `json:"path"`
+	// Header is encoded to JSON as the first value of each key for backward compatibility,
+	// all values are encoded as headers
+	Header Values  `json:"header"`
+	Body   Message `json:"body"`
+	// Query is encoded to JSON as the first value of each key like Header,
+	// all values are encoded as queries
+	Query map[string][]string `json:"query"`
+	// Params contains the named variables of path, such as id of /users/{id}
+	Params map[string]string `json:"params"`
+	// Cookies contains the cookies of http request
+	Cookies map[string]string `json:"cookies,omitempty"`
+	// RemoteAddr is the network address of client, IP is the host part of it
+	RemoteAddr string `json:"remoteAddr"`
+	IP         string `json:"ip"`
+	// Timestamp is the time when the request is received
+	Timestamp time.Time `json:"timestamp"`
+	// Proto is the HTTP version of request, such as HTTP/1.1, HTTP/2.0 and HTTP/3.0
+	Proto string `json:"proto"`
+	// Messages contains all received messages of streaming requests,
+	// Body is the latest one
+	Messages []Message `json:"messages,omitempty"`
+	// TLS is nil if the request is not received over TLS
+	TLS *TLSInfo `json:"tls,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (r *Request) MarshalJSON() ([]byte, error) {
+	type request Request
+	return json.Marshal(&struct {
+		*request
+		Header  map[string]string   `json:"header"`
+		Headers Values              `json:"headers"`
+		Query   map[string]string   `json:"query"`
+		Queries map[string][]string `json:"queries"`
+	}{
+		request: (*request)(r),
+		Header:  firstValues(r.Header),
+		Headers: r.Header,
+		Query:   firstValues(r.Query),
+		Queries: r.Query,
+	})
+}
+
+// SetRemoteAddr is used to set RemoteAddr and IP of request
+func (r *Request) SetRemoteAddr(addr string) {
+	r.RemoteAddr = addr
+	r.IP = addr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		r.IP = host
+	}
 }
 
 // Response defines the response structure
 type Response struct {
-	Code    uint32            `json:"code"`
-	Header  map[string]string `json:"header"`
-	Body    Message           `json:"body"`
-	Trailer map[string]string `json:"trailer"`
+	Code    uint32  `json:"code"`
+	Header  Values  `json:"header"`
+	Body    Message `json:"body"`
+	Trailer Values  `json:"trailer"`
+	// Stream is the sequence of messages for streaming responses,
+	// Body is ignored if Stream is not empty
+	Stream []*StreamMessage `json:"stream,omitempty"`
+	// Content is used instead of Body if it is not nil, such as files and blobs
+	Content *Content `json:"-"`
+	// Status is the message and details of gRPC errors, it is only used if Code is not 0
+	Status *Status `json:"status,omitempty"`
+}
+
+// Status defines the message and details of gRPC errors
+type Status struct {
+	Message string          `json:"message"`
+	Details []*StatusDetail `json:"details,omitempty"`
+}
+
+// StatusDetail defines a detail of gRPC status,
+// Body is the JSON format of message until it is converted to binary by the grpc plugin
+type StatusDetail struct {
+	// Type is the fully qualified name of message, such as google.rpc.ErrorInfo
+	Type string  `json:"type"`
+	Body Message `json:"body"`
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface
+func (s *StatusDetail) UnmarshalJSON(data []byte) error {
+	var detail struct {
+		Type string          `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+	if err := json.Unmarshal(data, &detail); err != nil {
+		return err
+	}
+	s.Type = detail.Type
+	s.Body = NewBytesMessage(detail.Body)
+	return nil
+}
+
+// Content defines a binary body which is sent as is,
+// it can be served efficiently with range requests supported by the HTTP mock server
+type Content struct {
+	// Name is used to detect the content type by extension
+	Name    string
+	ModTime time.Time
+	// Open is used to open the content for reading
+	Open func() (io.ReadSeekCloser, error)
+}
+
+// NewBytesContent is used to create Content from data in memory
+func NewBytesContent(name string, data []byte) *Content {
+	return &Content{
+		Name: name,
+		Open: func() (io.ReadSeekCloser, error) {
+			return nopCloser{bytes.NewReader(data)}, nil
+		},
+	}
+}
+
+// ReadAll is used to read all data of content
+func (c *Content) ReadAll() ([]byte, error) {
+	reader, err := c.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+type nopCloser struct {
+	io.ReadSeeker
+}
+
+// Close implements the io.Closer interface
+func (nopCloser) Close() error {
+	return nil
+}
+
+// StreamMessage defines a message of streaming response
+type StreamMessage struct {
+	Body Message `json:"body"`
+	// Delay is the milliseconds to wait before sending this message
+	Delay uint32 `json:"delay"`
+	// Event and ID are only used by Server-Sent Events
+	Event string `json:"event,omitempty"`
+	ID    string `json:"id,omitempty"`
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface
+func (s *StreamMessage) UnmarshalJSON(data []byte) error {
+	var message struct {
+		Body  json.RawMessage `json:"body"`
+		Delay uint32          `json:"delay"`
+		Event string          `json:"event"`
+		ID    string          `json:"id"`
+	}
+	if err := json.Unmarshal(data, &message); err != nil {
+		return err
+	}
+	s.Body = NewBytesMessage(message.Body)
+	s.Delay = message.Delay
+	s.Event = message.Event
+	s.ID = message.ID
+	return nil
 }
 
 // NewDefaultResponse is used to create default response
 func NewDefaultResponse(request *Request) *Response {
 	var code uint32
 	switch request.Protocol {
-	case ProtocolGRPC:
+	case ProtocolGRPC, ProtocolGRPCWeb, ProtocolConnect:
 		code = 0
 	case ProtocolHTTP:
 		code = 1
 	}
 	return &Response{
 		Code:    code,
-		Header:  map[string]string{},
-		Trailer: map[string]string{},
+		Header:  Values{},
+		Trailer: Values{},
 		Body:    NewBytesMessage(nil),
 	}
 }
@@ -119,3 +282,27 @@ func (b *BytesMessage) MarshalJSON() ([]byte, error) {
 func (b *BytesMessage) Bytes() []byte {
 	return b.data
 }
+
+// ParsedMessage is the implement of Message whose body is not JSON,
+// it keeps the raw data and is encoded to JSON as the parsed result,
+// such as the fields of form and the elements of XML
+type ParsedMessage struct {
+	BytesMessage
+	parsed []byte
+}
+
+// NewParsedMessage is used to init ParsedMessage with raw data and the parsed JSON
+func NewParsedMessage(data []byte, parsed []byte) Message {
+	return &ParsedMessage{
+		BytesMessage: BytesMessage{data: data},
+		parsed:       parsed,
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *ParsedMessage) MarshalJSON() ([]byte, error) {
+	if len(p.parsed) == 0 {
+		return []byte(`null`), nil
+	}
+	return p.parsed, nil
+}
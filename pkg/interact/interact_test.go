@@ -0,0 +1,75 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interact
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetRemoteAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		ip   string
+	}{
+		{addr: "127.0.0.1:8080", ip: "127.0.0.1"},
+		{addr: "[::1]:8080", ip: "::1"},
+		{addr: "127.0.0.1", ip: "127.0.0.1"},
+		{addr: "@", ip: "@"},
+		{addr: "", ip: ""},
+	}
+	for _, test := range tests {
+		request := &Request{}
+		request.SetRemoteAddr(test.addr)
+		assert.Equal(t, test.addr, request.RemoteAddr, test.addr)
+		assert.Equal(t, test.ip, request.IP, test.addr)
+	}
+}
+
+func TestRequestMarshalJSON(t *testing.T) {
+	request := &Request{
+		Protocol:  ProtocolHTTP,
+		Method:    "GET",
+		Path:      "/users/1",
+		Header:    NewValues(map[string][]string{"X-Tag": {"a", "b"}}),
+		Body:      NewBytesMessage([]byte(`{"name": "powermock"}`)),
+		Query:     map[string][]string{"page": {"1"}, "tag": {"a", "b"}, "empty": {}},
+		Params:    map[string]string{"id": "1"},
+		Timestamp: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Proto:     "HTTP/1.1",
+	}
+	request.SetRemoteAddr("127.0.0.1:8080")
+	data, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"protocol": "HTTP",
+		"method": "GET",
+		"host": "",
+		"path": "/users/1",
+		"header": {"x-tag": "a"},
+		"headers": {"x-tag": ["a", "b"]},
+		"body": {"name": "powermock"},
+		"query": {"page": "1", "tag": "a"},
+		"queries": {"page": ["1"], "tag": ["a", "b"], "empty": []},
+		"params": {"id": "1"},
+		"remoteAddr": "127.0.0.1:8080",
+		"ip": "127.0.0.1",
+		"timestamp": "2021-01-01T00:00:00Z",
+		"proto": "HTTP/1.1"
+	}`, string(data))
+}
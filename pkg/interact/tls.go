@@ -0,0 +1,98 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interact
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"time"
+)
+
+var tlsVersions = map[uint16]string{
+	tls.VersionTLS10: "TLS 1.0",
+	tls.VersionTLS11: "TLS 1.1",
+	tls.VersionTLS12: "TLS 1.2",
+	tls.VersionTLS13: "TLS 1.3",
+}
+
+// TLSInfo defines the TLS connection information of request
+type TLSInfo struct {
+	Version            string `json:"version"`
+	CipherSuite        string `json:"cipherSuite"`
+	ServerName         string `json:"serverName"`
+	NegotiatedProtocol string `json:"negotiatedProtocol"`
+	// PeerCertificates are the certificates sent by the client, the first one is the leaf
+	PeerCertificates []*Certificate `json:"peerCertificates"`
+}
+
+// Certificate defines the information of a x509 certificate
+type Certificate struct {
+	Subject        string    `json:"subject"`
+	CommonName     string    `json:"commonName"`
+	Issuer         string    `json:"issuer"`
+	SerialNumber   string    `json:"serialNumber"`
+	DNSNames       []string  `json:"dnsNames"`
+	EmailAddresses []string  `json:"emailAddresses"`
+	IPAddresses    []string  `json:"ipAddresses"`
+	URIs           []string  `json:"uris"`
+	NotBefore      time.Time `json:"notBefore"`
+	NotAfter       time.Time `json:"notAfter"`
+	// Fingerprint is the hex encoded SHA-256 digest of the certificate
+	Fingerprint string `json:"fingerprint"`
+}
+
+// NewTLSInfo is used to create TLSInfo from the connection state
+func NewTLSInfo(state *tls.ConnectionState) *TLSInfo {
+	if state == nil {
+		return nil
+	}
+	info := &TLSInfo{
+		Version:            tlsVersions[state.Version],
+		CipherSuite:        tls.CipherSuiteName(state.CipherSuite),
+		ServerName:         state.ServerName,
+		NegotiatedProtocol: state.NegotiatedProtocol,
+		PeerCertificates:   []*Certificate{},
+	}
+	for _, cert := range state.PeerCertificates {
+		info.PeerCertificates = append(info.PeerCertificates, newCertificate(cert))
+	}
+	return info
+}
+
+func newCertificate(cert *x509.Certificate) *Certificate {
+	fingerprint := sha256.Sum256(cert.Raw)
+	c := &Certificate{
+		Subject:        cert.Subject.String(),
+		CommonName:     cert.Subject.CommonName,
+		Issuer:         cert.Issuer.String(),
+		SerialNumber:   cert.SerialNumber.String(),
+		DNSNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+		IPAddresses:    []string{},
+		URIs:           []string{},
+		NotBefore:      cert.NotBefore,
+		NotAfter:       cert.NotAfter,
+		Fingerprint:    hex.EncodeToString(fingerprint[:]),
+	}
+	for _, ip := range cert.IPAddresses {
+		c.IPAddresses = append(c.IPAddresses, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		c.URIs = append(c.URIs, uri.String())
+	}
+	return c
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interact
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Values defines multi-valued headers, trailers and gRPC metadata
+// Keys are case-insensitive and stored in lower case
+type Values map[string][]string
+
+// NewValues is used to create Values from map[string][]string, such as http.Header and metadata.MD
+func NewValues(input map[string][]string) Values {
+	values := Values{}
+	for key, val := range input {
+		for _, v := range val {
+			values.Add(key, v)
+		}
+	}
+	return values
+}
+
+// Get is used to return the first value of key
+func (v Values) Get(key string) string {
+	values := v[strings.ToLower(key)]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Values is used to return all values of key
+func (v Values) Values(key string) []string {
+	return v[strings.ToLower(key)]
+}
+
+// Set is used to replace the values of key with value
+func (v Values) Set(key, value string) {
+	v[strings.ToLower(key)] = []string{value}
+}
+
+// Add is used to append value to the values of key
+func (v Values) Add(key, value string) {
+	key = strings.ToLower(key)
+	v[key] = append(v[key], value)
+}
+
+// Del is used to delete the values of key
+func (v Values) Del(key string) {
+	delete(v, strings.ToLower(key))
+}
+
+// First is used to return the first value of each key
+func (v Values) First() map[string]string {
+	return firstValues(v)
+}
+
+// UnmarshalJSON implements the json.UnmarshalJSON interface
+// Both a single string and a list of strings are accepted as values,
+// and the values of keys in data replace the existing ones
+func (v *Values) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if *v == nil {
+		*v = Values{}
+	}
+	for key, item := range raw {
+		var list []string
+		var value string
+		if err := json.Unmarshal(item, &value); err == nil {
+			list = []string{value}
+		} else if err := json.Unmarshal(item, &list); err != nil {
+			return err
+		}
+		v.Del(key)
+		for _, value := range list {
+			v.Add(key, value)
+		}
+	}
+	return nil
+}
+
+// firstValues is used to return the first value of each key
+func firstValues(input map[string][]string) map[string]string {
+	first := map[string]string{}
+	for key, values := range input {
+		if len(values) > 0 {
+			first[key] = values[0]
+		}
+	}
+	return first
+}
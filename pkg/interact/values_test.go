@@ -0,0 +1,68 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interact
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	values := NewValues(map[string][]string{"X-Tag": {"a", "b"}, "Content-Type": {"text/plain"}})
+	assert.Equal(t, Values{"x-tag": {"a", "b"}, "content-type": {"text/plain"}}, values)
+	assert.Equal(t, "text/plain", values.Get("CONTENT-TYPE"))
+	assert.Equal(t, []string{"a", "b"}, values.Values("X-TAG"))
+	assert.Equal(t, "", values.Get("unknown"))
+	assert.Nil(t, values.Values("unknown"))
+
+	values.Add("Set-Cookie", "a=1")
+	values.Add("set-cookie", "b=2")
+	assert.Equal(t, []string{"a=1", "b=2"}, values.Values("set-cookie"))
+	values.Set("X-Tag", "c")
+	assert.Equal(t, []string{"c"}, values.Values("x-tag"))
+	values.Del("Content-Type")
+	assert.Equal(t, map[string]string{"x-tag": "c", "set-cookie": "a=1"}, values.First())
+}
+
+func TestValuesUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		expect    Values
+		expectErr bool
+	}{
+		{name: "single", data: `{"X-Tag": "b"}`, expect: Values{"x-tag": {"b"}, "x-keep": {"k"}}},
+		{name: "multiple", data: `{"x-tag": ["b", "c"]}`, expect: Values{"x-tag": {"b", "c"}, "x-keep": {"k"}}},
+		{name: "empty", data: `{"x-tag": []}`, expect: Values{"x-keep": {"k"}}},
+		{name: "invalid value", data: `{"x-tag": 1}`, expectErr: true},
+		{name: "invalid", data: `[]`, expectErr: true},
+	}
+	for _, test := range tests {
+		values := Values{"x-tag": {"a"}, "x-keep": {"k"}}
+		err := json.Unmarshal([]byte(test.data), &values)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expect, values, test.name)
+	}
+
+	var values Values
+	assert.Nil(t, json.Unmarshal([]byte(`{"a": "1"}`), &values))
+	assert.Equal(t, Values{"a": {"1"}}, values)
+}
@@ -0,0 +1,251 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"io"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	_ "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+)
+
+// reflectionServiceNames defines the service names of server reflection,
+// grpc.reflection.v1 is wire compatible with grpc.reflection.v1alpha
+var reflectionServiceNames = []string{
+	"grpc.reflection.v1alpha.ServerReflection",
+	"grpc.reflection.v1.ServerReflection",
+}
+
+// reflectionV1File is the descriptor of grpc.reflection.v1, which is registered by grpc_reflection_v1
+var reflectionV1File, _ = desc.LoadFileDescriptor("grpc/reflection/v1/reflection.proto")
+
+// reflectionServer implements the gRPC server reflection service
+// It is backed by the descriptors loaded by protomanager,
+// so the reflected services are always the same as the latest loaded protos
+type reflectionServer struct {
+	protoManager protomanager.Provider
+}
+
+// registerReflectionServer is used to register the server reflection services
+func registerReflectionServer(server *grpc.Server, protoManager protomanager.Provider) {
+	srv := &reflectionServer{protoManager: protoManager}
+	for _, name := range reflectionServiceNames {
+		serviceDesc := rpb.ServerReflection_ServiceDesc
+		serviceDesc.ServiceName = name
+		server.RegisterService(&serviceDesc, srv)
+	}
+}
+
+// ServerReflectionInfo implements the interface of rpb.ServerReflectionServer
+func (r *reflectionServer) ServerReflectionInfo(stream rpb.ServerReflection_ServerReflectionInfoServer) error {
+	sent := map[string]bool{}
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		files := r.getFiles()
+		out := &rpb.ServerReflectionResponse{
+			ValidHost:       in.GetHost(),
+			OriginalRequest: in,
+		}
+		switch req := in.GetMessageRequest().(type) {
+		case *rpb.ServerReflectionRequest_FileByFilename:
+			fd, ok := files[req.FileByFilename]
+			if !ok {
+				fd, err = desc.LoadFileDescriptor(req.FileByFilename)
+			}
+			setFileDescriptorResponse(out, fd, err, sent)
+		case *rpb.ServerReflectionRequest_FileContainingSymbol:
+			fd, err := findFileBySymbol(files, req.FileContainingSymbol)
+			setFileDescriptorResponse(out, fd, err, sent)
+		case *rpb.ServerReflectionRequest_FileContainingExtension:
+			var fd *desc.FileDescriptor
+			for _, file := range files {
+				ext := file.FindExtension(req.FileContainingExtension.GetContainingType(), req.FileContainingExtension.GetExtensionNumber())
+				if ext != nil {
+					fd = file
+					break
+				}
+			}
+			if fd == nil {
+				err = status.Errorf(codes.NotFound, "extension not found")
+			}
+			setFileDescriptorResponse(out, fd, err, sent)
+		case *rpb.ServerReflectionRequest_AllExtensionNumbersOfType:
+			numbers, err := findExtensionNumbers(files, req.AllExtensionNumbersOfType)
+			if err != nil {
+				setErrorResponse(out, err)
+				break
+			}
+			out.MessageResponse = &rpb.ServerReflectionResponse_AllExtensionNumbersResponse{
+				AllExtensionNumbersResponse: &rpb.ExtensionNumberResponse{
+					BaseTypeName:    req.AllExtensionNumbersOfType,
+					ExtensionNumber: numbers,
+				},
+			}
+		case *rpb.ServerReflectionRequest_ListServices:
+			out.MessageResponse = &rpb.ServerReflectionResponse_ListServicesResponse{
+				ListServicesResponse: &rpb.ListServiceResponse{
+					Service: r.listServices(),
+				},
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid MessageRequest: %v", in.GetMessageRequest())
+		}
+		if err := stream.Send(out); err != nil {
+			return err
+		}
+	}
+}
+
+// getFiles is used to get all loaded files and their dependencies
+func (r *reflectionServer) getFiles() map[string]*desc.FileDescriptor {
+	files := map[string]*desc.FileDescriptor{}
+	var walk func(fd *desc.FileDescriptor)
+	walk = func(fd *desc.FileDescriptor) {
+		if _, ok := files[fd.GetName()]; ok {
+			return
+		}
+		files[fd.GetName()] = fd
+		for _, dependency := range fd.GetDependencies() {
+			walk(dependency)
+		}
+	}
+	for _, file := range r.protoManager.ListFiles() {
+		walk(file.Descriptor)
+	}
+	if reflectionV1File != nil {
+		walk(reflectionV1File)
+	}
+	return files
+}
+
+func (r *reflectionServer) listServices() []*rpb.ServiceResponse {
+	var services []*rpb.ServiceResponse
+	for _, file := range r.protoManager.ListFiles() {
+		for _, service := range file.Descriptor.GetServices() {
+			services = append(services, &rpb.ServiceResponse{Name: service.GetFullyQualifiedName()})
+		}
+	}
+	for _, name := range reflectionServiceNames {
+		services = append(services, &rpb.ServiceResponse{Name: name})
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+	return services
+}
+
+func findFileBySymbol(files map[string]*desc.FileDescriptor, symbol string) (*desc.FileDescriptor, error) {
+	for _, fd := range files {
+		if fd.FindSymbol(symbol) != nil {
+			return fd, nil
+		}
+	}
+	// fallback to the registered files, such as the reflection service itself
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "symbol not found: %s", symbol)
+	}
+	return desc.LoadFileDescriptor(d.ParentFile().Path())
+}
+
+func findExtensionNumbers(files map[string]*desc.FileDescriptor, typeName string) ([]int32, error) {
+	var found bool
+	var numbers []int32
+	for _, fd := range files {
+		if fd.FindMessage(typeName) != nil {
+			found = true
+		}
+		var extensions []*desc.FieldDescriptor
+		extensions = append(extensions, fd.GetExtensions()...)
+		for _, message := range fd.GetMessageTypes() {
+			extensions = append(extensions, message.GetNestedExtensions()...)
+		}
+		for _, ext := range extensions {
+			if ext.GetOwner().GetFullyQualifiedName() == typeName {
+				numbers = append(numbers, ext.GetNumber())
+			}
+		}
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "type not found: %s", typeName)
+	}
+	sort.Slice(numbers, func(i, j int) bool {
+		return numbers[i] < numbers[j]
+	})
+	return numbers, nil
+}
+
+// setFileDescriptorResponse is used to set the file and its dependencies which have not been sent in the stream
+func setFileDescriptorResponse(out *rpb.ServerReflectionResponse, fd *desc.FileDescriptor, err error, sent map[string]bool) {
+	if err != nil {
+		setErrorResponse(out, err)
+		return
+	}
+	var data [][]byte
+	var walk func(fd *desc.FileDescriptor, required bool) error
+	walk = func(fd *desc.FileDescriptor, required bool) error {
+		if sent[fd.GetName()] && !required {
+			return nil
+		}
+		raw, err := proto.Marshal(fd.AsFileDescriptorProto())
+		if err != nil {
+			return err
+		}
+		sent[fd.GetName()] = true
+		data = append(data, raw)
+		for _, dependency := range fd.GetDependencies() {
+			if err := walk(dependency, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(fd, true); err != nil {
+		setErrorResponse(out, err)
+		return
+	}
+	out.MessageResponse = &rpb.ServerReflectionResponse_FileDescriptorResponse{
+		FileDescriptorResponse: &rpb.FileDescriptorResponse{FileDescriptorProto: data},
+	}
+}
+
+func setErrorResponse(out *rpb.ServerReflectionResponse, err error) {
+	code := status.Code(err)
+	if code == codes.Unknown {
+		code = codes.NotFound
+	}
+	out.MessageResponse = &rpb.ServerReflectionResponse_ErrorResponse{
+		ErrorResponse: &rpb.ErrorResponse{
+			ErrorCode:    int32(code),
+			ErrorMessage: status.Convert(err).Message(),
+		},
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+)
+
+func newReflectionClient(t *testing.T) rpb.ServerReflectionClient {
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"common.proto": `syntax = "proto2"; package test;
+message Empty { extensions 100 to 200; }`,
+			"greeter.proto": `syntax = "proto2"; package test; import "common.proto";
+service Greeter { rpc Hello(Empty) returns (Empty); }
+extend Empty { optional string name = 101; }`,
+		}),
+	}
+	fds, err := parser.ParseFiles("greeter.proto")
+	assert.Nil(t, err)
+	manager := &fakeProtoManager{files: []*protomanager.FileInfo{{Descriptor: fds[0], Path: "greeter.proto"}}}
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	registerReflectionServer(server, manager)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return rpb.NewServerReflectionClient(conn)
+}
+
+func TestReflection(t *testing.T) {
+	client := newReflectionClient(t)
+	tests := []struct {
+		name    string
+		request *rpb.ServerReflectionRequest
+		// files are the names of returned file descriptors
+		files []string
+		// code is the code of error response
+		code codes.Code
+	}{
+		{
+			name:    "file by filename",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: "greeter.proto"}},
+			files:   []string{"greeter.proto", "common.proto"},
+		},
+		{
+			name:    "file by filename not found",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: "unknown.proto"}},
+			code:    codes.NotFound,
+		},
+		{
+			name:    "file containing service",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "test.Greeter"}},
+			files:   []string{"greeter.proto", "common.proto"},
+		},
+		{
+			name:    "file containing method",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "test.Greeter.Hello"}},
+			files:   []string{"greeter.proto", "common.proto"},
+		},
+		{
+			name:    "file containing message",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "test.Empty"}},
+			files:   []string{"common.proto"},
+		},
+		{
+			name:    "file containing reflection v1",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "grpc.reflection.v1.ServerReflection"}},
+			files:   []string{"grpc/reflection/v1/reflection.proto"},
+		},
+		{
+			name:    "file containing reflection v1alpha",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "grpc.reflection.v1alpha.ServerReflection"}},
+			files:   []string{"grpc/reflection/v1alpha/reflection.proto"},
+		},
+		{
+			name:    "file containing unknown symbol",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "test.Unknown"}},
+			code:    codes.NotFound,
+		},
+		{
+			name: "file containing extension",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingExtension{
+				FileContainingExtension: &rpb.ExtensionRequest{ContainingType: "test.Empty", ExtensionNumber: 101},
+			}},
+			files: []string{"greeter.proto", "common.proto"},
+		},
+		{
+			name: "file containing unknown extension",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_FileContainingExtension{
+				FileContainingExtension: &rpb.ExtensionRequest{ContainingType: "test.Empty", ExtensionNumber: 102},
+			}},
+			code: codes.NotFound,
+		},
+		{
+			name:    "unknown type of extension numbers",
+			request: &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_AllExtensionNumbersOfType{AllExtensionNumbersOfType: "test.Unknown"}},
+			code:    codes.NotFound,
+		},
+	}
+	for _, test := range tests {
+		// use a new stream for each case, so that dependencies are not skipped as they have been sent
+		stream, err := client.ServerReflectionInfo(context.TODO())
+		assert.Nil(t, err, test.name)
+		assert.Nil(t, stream.Send(test.request), test.name)
+		resp, err := stream.Recv()
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, int32(test.code), resp.GetErrorResponse().GetErrorCode(), test.name)
+		var files []string
+		for _, raw := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			files = append(files, getFileName(t, raw))
+		}
+		assert.Equal(t, test.files, files, test.name)
+		assert.Nil(t, stream.CloseSend(), test.name)
+	}
+}
+
+func TestReflectionListServices(t *testing.T) {
+	client := newReflectionClient(t)
+	stream, err := client.ServerReflectionInfo(context.TODO())
+	assert.Nil(t, err)
+	assert.Nil(t, stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	assert.Nil(t, err)
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	assert.Equal(t, []string{
+		"grpc.reflection.v1.ServerReflection",
+		"grpc.reflection.v1alpha.ServerReflection",
+		"test.Greeter",
+	}, services)
+
+	assert.Nil(t, stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_AllExtensionNumbersOfType{AllExtensionNumbersOfType: "test.Empty"},
+	}))
+	resp, err = stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{101}, resp.GetAllExtensionNumbersResponse().GetExtensionNumber())
+
+	// dependencies which have been sent in the stream are skipped
+	for _, expect := range [][]string{{"greeter.proto", "common.proto"}, {"greeter.proto"}} {
+		assert.Nil(t, stream.Send(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: "greeter.proto"},
+		}))
+		resp, err = stream.Recv()
+		assert.Nil(t, err)
+		var files []string
+		for _, raw := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			files = append(files, getFileName(t, raw))
+		}
+		assert.Equal(t, expect, files)
+	}
+}
+
+func getFileName(t *testing.T, raw []byte) string {
+	var fd descriptor.FileDescriptorProto
+	assert.Nil(t, proto.Unmarshal(raw, &fd))
+	return fd.GetName()
+}
@@ -16,24 +16,36 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/dynamic"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/bilibili-base/powermock/pkg/apimanager"
 	"github.com/bilibili-base/powermock/pkg/interact"
 	"github.com/bilibili-base/powermock/pkg/protomanager"
 	"github.com/bilibili-base/powermock/pkg/util"
 	"github.com/bilibili-base/powermock/pkg/util/logger"
+	"github.com/bilibili-base/powermock/pkg/util/tlsconfig"
 	_ "google.golang.org/grpc/encoding/gzip"
 )
 
@@ -56,17 +68,23 @@ type MockServer struct {
 
 // Config defines the config structure
 type Config struct {
-	Enable       bool
-	Address      string
-	ProtoManager *protomanager.Config
+	Enable           bool
+	Address          string
+	EnableReflection bool
+	EnableWeb        bool
+	TLS              *tlsconfig.Config
+	ProtoManager     *protomanager.Config
 }
 
 // NewConfig is used to init config with default values
 func NewConfig() *Config {
 	return &Config{
-		Enable:       true,
-		Address:      "0.0.0.0:30002",
-		ProtoManager: protomanager.NewConfig(),
+		Enable:           true,
+		Address:          "0.0.0.0:30002",
+		EnableReflection: true,
+		EnableWeb:        false,
+		TLS:              tlsconfig.NewConfig(),
+		ProtoManager:     protomanager.NewConfig(),
 	}
 }
 
@@ -79,8 +97,11 @@ func (c *Config) IsEnabled() bool {
 // RegisterFlagsWithPrefix is used to register flags
 func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
 	c.ProtoManager.RegisterFlagsWithPrefix(prefix+"gRPCMockServer.", f)
+	c.TLS.RegisterFlagsWithPrefix(prefix+"gRPCMockServer.", f)
 	f.BoolVar(&c.Enable, prefix+"gRPCMockServer.enable", c.Enable, "define whether the component is enabled")
 	f.StringVar(&c.Address, prefix+"gRPCMockServer.address", c.Address, "address to listen")
+	f.BoolVar(&c.EnableWeb, prefix+"gRPCMockServer.enableWeb", c.EnableWeb, "define whether to accept gRPC-Web and Connect requests, native gRPC requests are served by the HTTP server if it is enabled")
+	f.BoolVar(&c.EnableReflection, prefix+"gRPCMockServer.enableReflection", c.EnableReflection, "define whether to serve the gRPC server reflection service")
 }
 
 // Validate is used to validate config and returns error on failure
@@ -88,7 +109,7 @@ func (c *Config) Validate() error {
 	if c.Address == "" {
 		return errors.New("the address of mockserver is required")
 	}
-	return util.CheckErrors(c.ProtoManager.Validate())
+	return util.CheckErrors(c.ProtoManager.Validate(), c.TLS.Validate())
 }
 
 // New is used to init service
@@ -120,16 +141,55 @@ func (s *MockServer) Start(ctx context.Context, cancelFunc context.CancelFunc) e
 	}
 
 	s.LogInfo(nil, "starting gRPC mock server on: %s", s.cfg.Address)
-	server := grpc.NewServer(grpc.UnknownServiceHandler(s.handleStream))
+	var tlsConfig *tls.Config
+	if s.cfg.TLS.IsEnabled() {
+		tlsManager, err := tlsconfig.New(s.cfg.TLS, s.Logger)
+		if err != nil {
+			return err
+		}
+		tlsConfig = tlsManager.TLSConfig("h2", "http/1.1")
+	}
+	options := []grpc.ServerOption{grpc.UnknownServiceHandler(s.handleStream)}
+	if tlsConfig != nil && !s.cfg.EnableWeb {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	server := grpc.NewServer(options...)
+	if s.cfg.EnableReflection {
+		registerReflectionServer(server, s.protoManager)
+	}
 	listener, err := net.Listen("tcp", s.cfg.Address)
 	if err != nil {
 		return err
 	}
+	if !s.cfg.EnableWeb {
+		util.StartServiceAsync(ctx, cancelFunc, s.Logger.NewLogger("gRPC"), func() error {
+			return server.Serve(listener)
+		}, func() error {
+			server.GracefulStop()
+			return nil
+		})
+		return nil
+	}
+
+	// serve native gRPC, gRPC-Web and Connect on the same address with h2c
+	httpServer := &http.Server{
+		Handler:   h2c.NewHandler(s.serveHTTP(server), &http2.Server{}),
+		TLSConfig: tlsConfig,
+	}
 	util.StartServiceAsync(ctx, cancelFunc, s.Logger.NewLogger("gRPC"), func() error {
-		return server.Serve(listener)
-	}, func() error {
-		server.GracefulStop()
+		serve := httpServer.Serve
+		if tlsConfig != nil {
+			serve = func(listener net.Listener) error {
+				return httpServer.ServeTLS(listener, "", "")
+			}
+		}
+		if err := serve(listener); err != http.ErrServerClosed {
+			return err
+		}
 		return nil
+	}, func() error {
+		server.Stop()
+		return httpServer.Shutdown(context.TODO())
 	})
 	return nil
 }
@@ -165,49 +225,174 @@ func (s *MockServer) handleStream(srv interface{}, stream grpc.ServerStream) err
 	if !ok {
 		return status.Errorf(codes.NotFound, "method not found")
 	}
+	protocol, proto := interact.ProtocolGRPC, "HTTP/2.0"
+	if webStream, ok := stream.(*webStream); ok {
+		protocol, proto = webStream.protocol, webStream.proto
+	}
+	newRequest := func(messages []interact.Message) *interact.Request {
+		body := interact.NewBytesMessage(nil)
+		if len(messages) > 0 {
+			body = messages[len(messages)-1]
+		}
+		request := &interact.Request{
+			Protocol:  protocol,
+			Method:    http.MethodPost,
+			Host:      getAuthorityFromMetadata(md),
+			Path:      fullMethodName,
+			Header:    interact.NewValues(md),
+			Body:      body,
+			Messages:  messages,
+			Proto:     proto,
+			TLS:       getTLSInfoFromContext(stream.Context()),
+			Timestamp: time.Now(),
+		}
+		if p, ok := peer.FromContext(stream.Context()); ok && p.Addr != nil {
+			request.SetRemoteAddr(p.Addr.String())
+		}
+		return request
+	}
+
+	// bidi streaming: mock response for each incoming message
+	if method.IsClientStreaming() && method.IsServerStreaming() {
+		var messages []interact.Message
+		trailer := metadata.MD{}
+		for i := 0; ; i++ {
+			message, err := recvMessage(stream, method)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			messages = append(messages, message)
+			response, err := s.apiManager.MockResponse(stream.Context(), newRequest(messages))
+			if err != nil {
+				return err
+			}
+			for key, values := range response.Trailer {
+				trailer[key] = values
+			}
+			if err := sendResponse(stream, response, i == 0); err != nil {
+				stream.SetTrailer(trailer)
+				return err
+			}
+		}
+		stream.SetTrailer(trailer)
+		return nil
+	}
+
+	// unary, client streaming and server streaming: mock response once
+	var messages []interact.Message
+	for {
+		message, err := recvMessage(stream, method)
+		if err == io.EOF && method.IsClientStreaming() {
+			break
+		}
+		if err == io.EOF {
+			return status.Errorf(codes.Unknown, "failed to recv request")
+		}
+		if err != nil {
+			return err
+		}
+		messages = append(messages, message)
+		if !method.IsClientStreaming() {
+			break
+		}
+	}
+	response, err := s.apiManager.MockResponse(stream.Context(), newRequest(messages))
+	if err != nil {
+		return err
+	}
+	stream.SetTrailer(metadata.MD(response.Trailer))
+	return sendResponse(stream, response, true)
+}
+
+// recvMessage is used to receive a message and convert it to JSON
+func recvMessage(stream grpc.ServerStream, method *desc.MethodDescriptor) (interact.Message, error) {
 	request := dynamic.NewMessage(method.GetInputType())
 	if err := stream.RecvMsg(request); err != nil {
-		return status.Errorf(codes.Unknown, "failed to recv request")
+		if err == io.EOF {
+			return nil, err
+		}
+		// keep the status of error, such as the unsupported compression of gRPC-Web and Connect messages
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unknown, "failed to recv request")
 	}
 	data, err := request.MarshalJSONPB(&jsonpb.Marshaler{})
 	if err != nil {
-		return status.Errorf(codes.Unknown, "failed to marshal request")
-	}
-	response, err := s.apiManager.MockResponse(context.TODO(), &interact.Request{
-		Protocol: interact.ProtocolGRPC,
-		Method:   http.MethodPost,
-		Host:     getAuthorityFromMetadata(md),
-		Path:     fullMethodName,
-		Header:   getHeadersFromMetadata(md),
-		Body:     interact.NewBytesMessage(data),
-	})
-	if err != nil {
-		return err
+		return nil, status.Errorf(codes.Unknown, "failed to marshal request")
 	}
-	stream.SetTrailer(metadata.New(response.Trailer))
-	if len(response.Header) > 0 {
-		if err := stream.SetHeader(metadata.New(response.Header)); err != nil {
+	return interact.NewBytesMessage(data), nil
+}
+
+// sendResponse is used to send headers and messages of the response,
+// messages of stream are sent in sequence with their delays
+func sendResponse(stream grpc.ServerStream, response *interact.Response, withHeader bool) error {
+	if withHeader && len(response.Header) > 0 {
+		if err := stream.SetHeader(metadata.MD(response.Header)); err != nil {
 			return status.Errorf(codes.Unavailable, "failed to set header: %s", err)
 		}
 	}
 	if response.Code != 0 {
-		return status.Errorf(codes.Code(response.Code), "expected code is: %d", response.Code)
+		return newStatus(response).Err()
 	}
-	if err := stream.SendMsg(response.Body); err != nil {
-		return status.Errorf(codes.Internal, "failed to send message: %s", err)
+	if len(response.Stream) == 0 {
+		if err := stream.SendMsg(response.Body); err != nil {
+			return status.Errorf(codes.Internal, "failed to send message: %s", err)
+		}
+		return nil
+	}
+	for _, message := range response.Stream {
+		if message.Delay > 0 {
+			timer := time.NewTimer(time.Duration(message.Delay) * time.Millisecond)
+			select {
+			case <-stream.Context().Done():
+				timer.Stop()
+				return status.FromContextError(stream.Context().Err()).Err()
+			case <-timer.C:
+			}
+		}
+		if err := stream.SendMsg(message.Body); err != nil {
+			return status.Errorf(codes.Internal, "failed to send message: %s", err)
+		}
 	}
 	return nil
 }
 
-// getHeadersFromMetadata is used to convert Metadata to Headers
-func getHeadersFromMetadata(md metadata.MD) map[string]string {
-	headers := map[string]string{}
-	for key, values := range md {
-		if len(values) > 0 {
-			headers[key] = values[0]
+// newStatus is used to create the status of error response with its message and details,
+// the message defaults to "expected code is: <code>" if it is not specified
+func newStatus(response *interact.Response) *status.Status {
+	st := &spb.Status{
+		Code:    int32(response.Code),
+		Message: fmt.Sprintf("expected code is: %d", response.Code),
+	}
+	if response.Status != nil {
+		if response.Status.Message != "" {
+			st.Message = response.Status.Message
 		}
+		for _, detail := range response.Status.Details {
+			st.Details = append(st.Details, &anypb.Any{
+				TypeUrl: "type.googleapis.com/" + detail.Type,
+				Value:   detail.Body.Bytes(),
+			})
+		}
+	}
+	return status.FromProto(st)
+}
+
+// getTLSInfoFromContext is used to get TLS information from the peer of context
+func getTLSInfoFromContext(ctx context.Context) *interact.TLSInfo {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
 	}
-	return headers
+	return interact.NewTLSInfo(&info.State)
 }
 
 // getAuthorityFromMetadata is used to get authority from metadata
@@ -0,0 +1,340 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/jhump/protoreflect/dynamic/grpcdynamic"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bilibili-base/powermock/pkg/apimanager"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// fakeProtoManager is a proto manager with the given files
+type fakeProtoManager struct {
+	protomanager.Provider
+	files []*protomanager.FileInfo
+}
+
+func (m *fakeProtoManager) ListFiles() []*protomanager.FileInfo {
+	return m.files
+}
+
+func (m *fakeProtoManager) GetMethod(name string) (*desc.MethodDescriptor, bool) {
+	for _, file := range m.files {
+		for _, service := range file.Descriptor.GetServices() {
+			for _, method := range service.GetMethods() {
+				if protomanager.GetPathByFullyQualifiedName(method.GetFullyQualifiedName()) == name {
+					return method, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+// fakeAPIManager replies the names of all received messages, and replies each comma separated name
+// in a message with increasing delays if the method is server streaming
+type fakeAPIManager struct {
+	apimanager.Provider
+	protoManager *fakeProtoManager
+	requests     []*interact.Request
+}
+
+func (m *fakeAPIManager) MockResponse(ctx context.Context, request *interact.Request) (*interact.Response, error) {
+	m.requests = append(m.requests, request)
+	method, _ := m.protoManager.GetMethod(request.Path)
+	var names []string
+	for _, message := range request.Messages {
+		req := dynamic.NewMessage(method.GetInputType())
+		if err := req.UnmarshalJSONPB(&jsonpb.Unmarshaler{}, message.Bytes()); err != nil {
+			return nil, err
+		}
+		names = append(names, req.GetFieldByName("name").(string))
+	}
+	response := interact.NewDefaultResponse(request)
+	response.Header = interact.NewValues(map[string][]string{"x-names": {strings.Join(names, ",")}})
+	response.Trailer = interact.NewValues(map[string][]string{"x-count": {fmt.Sprint(len(names))}})
+	if names[len(names)-1] == "error" {
+		response.Code = uint32(codes.InvalidArgument)
+		return response, nil
+	}
+	if !method.IsServerStreaming() || method.IsClientStreaming() {
+		response.Body = newReply(method, strings.Join(names, ","))
+		return response, nil
+	}
+	for i, name := range strings.Split(names[0], ",") {
+		response.Stream = append(response.Stream, &interact.StreamMessage{
+			Body:  newReply(method, name),
+			Delay: uint32(i * 10),
+		})
+	}
+	return response, nil
+}
+
+func newReply(method *desc.MethodDescriptor, message string) interact.Message {
+	reply := dynamic.NewMessage(method.GetOutputType())
+	reply.SetFieldByName("message", message)
+	data, _ := reply.Marshal()
+	return interact.NewBytesMessage(data)
+}
+
+func newTestMockServer(t *testing.T) (*MockServer, *fakeAPIManager, *desc.ServiceDescriptor) {
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"greeter.proto": `syntax = "proto3"; package test;
+service Greeter {
+  rpc Unary(HelloRequest) returns (HelloReply);
+  rpc ServerStream(HelloRequest) returns (stream HelloReply);
+  rpc ClientStream(stream HelloRequest) returns (HelloReply);
+  rpc Bidi(stream HelloRequest) returns (stream HelloReply);
+}
+message HelloRequest { string name = 1; }
+message HelloReply { string message = 1; }`,
+		}),
+	}
+	fds, err := parser.ParseFiles("greeter.proto")
+	assert.Nil(t, err)
+	protoManager := &fakeProtoManager{files: []*protomanager.FileInfo{{Descriptor: fds[0], Path: "greeter.proto"}}}
+	apiManager := &fakeAPIManager{protoManager: protoManager}
+	mockServer := &MockServer{
+		cfg:          NewConfig(),
+		protoManager: protoManager,
+		apiManager:   apiManager,
+		Logger:       logger.NewDefault("test"),
+	}
+	return mockServer, apiManager, fds[0].FindService("test.Greeter")
+}
+
+func dialTestMockServer(t *testing.T, mockServer *MockServer) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnknownServiceHandler(mockServer.handleStream))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return conn
+}
+
+func TestHandleStream(t *testing.T) {
+	tests := []struct {
+		method  string
+		names   []string
+		replies []string
+		// requests is the number of mocked requests
+		requests int
+		header   string
+		trailer  string
+		code     codes.Code
+	}{
+		{method: "Unary", names: []string{"a"}, replies: []string{"a"}, requests: 1, header: "a", trailer: "1"},
+		{method: "Unary", names: []string{"error"}, requests: 1, header: "error", trailer: "1", code: codes.InvalidArgument},
+		{method: "ServerStream", names: []string{"a"}, replies: []string{"a"}, requests: 1, header: "a", trailer: "1"},
+		{method: "ServerStream", names: []string{"a,b,c"}, replies: []string{"a", "b", "c"}, requests: 1, header: "a,b,c", trailer: "1"},
+		{method: "ClientStream", names: []string{"a", "b", "c"}, replies: []string{"a,b,c"}, requests: 1, header: "a,b,c", trailer: "3"},
+		{method: "ClientStream", names: []string{"a", "error"}, requests: 1, header: "a,error", trailer: "2", code: codes.InvalidArgument},
+		{method: "Bidi", names: []string{"a", "b", "c"}, replies: []string{"a", "a,b", "a,b,c"}, requests: 3, header: "a", trailer: "3"},
+		{method: "Bidi", names: []string{"a", "error", "c"}, replies: []string{"a"}, requests: 2, header: "a", trailer: "2", code: codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		name := fmt.Sprintf("%s%v", test.method, test.names)
+		mockServer, apiManager, service := newTestMockServer(t)
+		stub := grpcdynamic.NewStub(dialTestMockServer(t, mockServer))
+		method := service.FindMethodByName(test.method)
+		newRequest := func(name string) *dynamic.Message {
+			request := dynamic.NewMessage(method.GetInputType())
+			request.SetFieldByName("name", name)
+			return request
+		}
+		var header, trailer metadata.MD
+		var replies []string
+		var err error
+		switch {
+		case !method.IsClientStreaming() && !method.IsServerStreaming():
+			var reply interface{ String() string }
+			reply, err = stub.InvokeRpc(context.TODO(), method, newRequest(test.names[0]), grpc.Header(&header), grpc.Trailer(&trailer))
+			if err == nil {
+				replies = append(replies, reply.(*dynamic.Message).GetFieldByName("message").(string))
+			}
+		case !method.IsClientStreaming():
+			stream, e := stub.InvokeRpcServerStream(context.TODO(), method, newRequest(test.names[0]))
+			assert.Nil(t, e, name)
+			for {
+				reply, e := stream.RecvMsg()
+				if e != nil {
+					if e != io.EOF {
+						err = e
+					}
+					break
+				}
+				replies = append(replies, reply.(*dynamic.Message).GetFieldByName("message").(string))
+			}
+			header, _ = stream.Header()
+			trailer = stream.Trailer()
+		case !method.IsServerStreaming():
+			stream, e := stub.InvokeRpcClientStream(context.TODO(), method)
+			assert.Nil(t, e, name)
+			for _, n := range test.names {
+				assert.Nil(t, stream.SendMsg(newRequest(n)), name)
+			}
+			reply, e := stream.CloseAndReceive()
+			if e == nil {
+				replies = append(replies, reply.(*dynamic.Message).GetFieldByName("message").(string))
+			}
+			err = e
+			header, _ = stream.Header()
+			trailer = stream.Trailer()
+		default:
+			stream, e := stub.InvokeRpcBidiStream(context.TODO(), method)
+			assert.Nil(t, e, name)
+			for _, n := range test.names {
+				assert.Nil(t, stream.SendMsg(newRequest(n)), name)
+				reply, e := stream.RecvMsg()
+				if e != nil {
+					err = e
+					break
+				}
+				replies = append(replies, reply.(*dynamic.Message).GetFieldByName("message").(string))
+			}
+			if err == nil {
+				assert.Nil(t, stream.CloseSend(), name)
+				_, e := stream.RecvMsg()
+				assert.Equal(t, io.EOF, e, name)
+			}
+			header, _ = stream.Header()
+			trailer = stream.Trailer()
+		}
+		assert.Equal(t, test.code, status.Code(err), name)
+		assert.Equal(t, test.replies, replies, name)
+		assert.Equal(t, []string{test.header}, header.Get("x-names"), name)
+		assert.Equal(t, []string{test.trailer}, trailer.Get("x-count"), name)
+		assert.Len(t, apiManager.requests, test.requests, name)
+		for i, request := range apiManager.requests {
+			assert.Equal(t, "/test.Greeter/"+test.method, request.Path, name)
+			// each request of bidi streaming contains the messages received so far
+			messages := len(test.names)
+			if method.IsClientStreaming() && method.IsServerStreaming() {
+				messages = i + 1
+			}
+			assert.Len(t, request.Messages, messages, name)
+			assert.Equal(t, request.Messages[len(request.Messages)-1], request.Body, name)
+		}
+	}
+}
+
+func TestHandleStreamWithDelay(t *testing.T) {
+	mockServer, _, service := newTestMockServer(t)
+	stub := grpcdynamic.NewStub(dialTestMockServer(t, mockServer))
+	method := service.FindMethodByName("ServerStream")
+	request := dynamic.NewMessage(method.GetInputType())
+	request.SetFieldByName("name", "a,b,c")
+
+	// messages are sent after delays of 0ms, 10ms and 20ms
+	start := time.Now()
+	stream, err := stub.InvokeRpcServerStream(context.TODO(), method, request)
+	assert.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = stream.RecvMsg()
+		assert.Nil(t, err)
+	}
+	_, err = stream.RecvMsg()
+	assert.Equal(t, io.EOF, err)
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+
+	// the stream is canceled while waiting for the delay of messages
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Millisecond)
+	defer cancel()
+	stream, err = stub.InvokeRpcServerStream(ctx, method, request)
+	assert.Nil(t, err)
+	_, err = stream.RecvMsg()
+	assert.Nil(t, err)
+	_, err = stream.RecvMsg()
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestHandleStreamMethodNotFound(t *testing.T) {
+	mockServer, _, _ := newTestMockServer(t)
+	err := dialTestMockServer(t, mockServer).Invoke(context.TODO(), "/test.Greeter/Unknown", interact.NewBytesMessage(nil), interact.NewBytesMessage(nil))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestNewStatus(t *testing.T) {
+	errorInfo, err := proto.Marshal(&errdetails.ErrorInfo{Reason: "NOT_FOUND", Domain: "powermock"})
+	assert.Nil(t, err)
+	tests := []struct {
+		name          string
+		response      *interact.Response
+		expectMessage string
+		expectDetails []proto.Message
+	}{
+		{
+			name:          "default message",
+			response:      &interact.Response{Code: 5},
+			expectMessage: "expected code is: 5",
+		},
+		{
+			name:          "default message with empty status",
+			response:      &interact.Response{Code: 5, Status: &interact.Status{}},
+			expectMessage: "expected code is: 5",
+		},
+		{
+			name: "message and details",
+			response: &interact.Response{Code: 5, Status: &interact.Status{
+				Message: "user is not found",
+				Details: []*interact.StatusDetail{{Type: "google.rpc.ErrorInfo", Body: interact.NewBytesMessage(errorInfo)}},
+			}},
+			expectMessage: "user is not found",
+			expectDetails: []proto.Message{&errdetails.ErrorInfo{Reason: "NOT_FOUND", Domain: "powermock"}},
+		},
+	}
+	for _, test := range tests {
+		st := newStatus(test.response)
+		assert.Equal(t, codes.NotFound, st.Code(), test.name)
+		assert.Equal(t, test.expectMessage, st.Message(), test.name)
+		details := st.Details()
+		assert.Len(t, details, len(test.expectDetails), test.name)
+		for i, detail := range details {
+			assert.True(t, proto.Equal(test.expectDetails[i], detail.(proto.Message)), test.name)
+		}
+	}
+}
@@ -0,0 +1,544 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+// defines the codecs of gRPC-Web and Connect messages
+const (
+	codecProto = "proto"
+	codecJSON  = "json"
+)
+
+// defines the flags of enveloped messages
+const (
+	flagCompressed       = 0x01
+	flagConnectEndStream = 0x02
+	flagGRPCWebTrailer   = 0x80
+)
+
+// connectCodes defines the code names and http status of Connect protocol
+var connectCodes = map[codes.Code]struct {
+	name       string
+	httpStatus int
+}{
+	codes.Canceled:           {"canceled", 499},
+	codes.Unknown:            {"unknown", http.StatusInternalServerError},
+	codes.InvalidArgument:    {"invalid_argument", http.StatusBadRequest},
+	codes.DeadlineExceeded:   {"deadline_exceeded", http.StatusGatewayTimeout},
+	codes.NotFound:           {"not_found", http.StatusNotFound},
+	codes.AlreadyExists:      {"already_exists", http.StatusConflict},
+	codes.PermissionDenied:   {"permission_denied", http.StatusForbidden},
+	codes.ResourceExhausted:  {"resource_exhausted", http.StatusTooManyRequests},
+	codes.FailedPrecondition: {"failed_precondition", http.StatusBadRequest},
+	codes.Aborted:            {"aborted", http.StatusConflict},
+	codes.OutOfRange:         {"out_of_range", http.StatusBadRequest},
+	codes.Unimplemented:      {"unimplemented", http.StatusNotImplemented},
+	codes.Internal:           {"internal", http.StatusInternalServerError},
+	codes.Unavailable:        {"unavailable", http.StatusServiceUnavailable},
+	codes.DataLoss:           {"data_loss", http.StatusInternalServerError},
+	codes.Unauthenticated:    {"unauthenticated", http.StatusUnauthorized},
+}
+
+// serveHTTP returns the handler which dispatches native gRPC requests to the gRPC server,
+// and serves gRPC-Web and Connect requests by itself
+func (s *MockServer) serveHTTP(server *grpc.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType := r.Header.Get("Content-Type")
+		if r.ProtoMajor == 2 && strings.HasPrefix(contentType, "application/grpc") &&
+			!strings.HasPrefix(contentType, "application/grpc-web") {
+			server.ServeHTTP(w, r)
+			return
+		}
+		if handleCORS(w, r) {
+			return
+		}
+		stream, err := newWebStream(w, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnsupportedMediaType)
+			return
+		}
+		defer stream.cancel()
+		if method, ok := s.protoManager.GetMethod(r.URL.Path); ok {
+			stream.method = method
+		}
+		stream.finish(s.handleStream(nil, stream))
+	})
+}
+
+// handleCORS is used to set CORS headers and returns true if the request is a preflight request
+func handleCORS(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
+	w.Header().Add("Vary", "Origin")
+	if r.Method != http.MethodOptions || r.Header.Get("Access-Control-Request-Method") == "" {
+		return false
+	}
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", r.Header.Get("Access-Control-Request-Headers"))
+	w.Header().Set("Access-Control-Max-Age", "7200")
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
+
+// webStream implements grpc.ServerStream over gRPC-Web and Connect requests,
+// so that they can be handled in the same way as native gRPC requests
+type webStream struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	protocol interact.Protocol
+	proto    string
+	method   *desc.MethodDescriptor
+	codec    string
+	// text defines whether the messages are base64 encoded (application/grpc-web-text)
+	text bool
+	// unary defines whether the request is a Connect unary request
+	unary       bool
+	contentType string
+
+	w        http.ResponseWriter
+	body     io.Reader
+	received bool
+
+	header       metadata.MD
+	trailer      metadata.MD
+	headerSent   bool
+	unaryMessage []byte
+}
+
+var _ grpc.ServerStream = &webStream{}
+
+func newWebStream(w http.ResponseWriter, r *http.Request) (*webStream, error) {
+	stream := &webStream{
+		proto:   r.Proto,
+		w:       w,
+		body:    r.Body,
+		header:  metadata.MD{},
+		trailer: metadata.MD{},
+	}
+	timeout := time.Duration(0)
+	if r.Method == http.MethodGet {
+		// Connect unary requests with GET method
+		query := r.URL.Query()
+		stream.protocol = interact.ProtocolConnect
+		stream.unary = true
+		stream.codec = query.Get("encoding")
+		if stream.codec != codecProto && stream.codec != codecJSON {
+			return nil, fmt.Errorf("unsupported encoding: %s", stream.codec)
+		}
+		stream.contentType = "application/" + stream.codec
+		message := []byte(query.Get("message"))
+		if query.Get("base64") == "1" {
+			decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(string(message), "="))
+			if err != nil {
+				return nil, fmt.Errorf("invalid message: %s", err)
+			}
+			message = decoded
+		}
+		stream.body = bytes.NewReader(message)
+	} else {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid content type: %s", err)
+		}
+		stream.contentType = mediaType
+		switch mediaType {
+		case "application/grpc-web", "application/grpc-web+proto":
+			stream.protocol, stream.codec = interact.ProtocolGRPCWeb, codecProto
+		case "application/grpc-web+json":
+			stream.protocol, stream.codec = interact.ProtocolGRPCWeb, codecJSON
+		case "application/grpc-web-text", "application/grpc-web-text+proto":
+			stream.protocol, stream.codec, stream.text = interact.ProtocolGRPCWeb, codecProto, true
+		case "application/connect+proto":
+			stream.protocol, stream.codec = interact.ProtocolConnect, codecProto
+		case "application/connect+json":
+			stream.protocol, stream.codec = interact.ProtocolConnect, codecJSON
+		case "application/proto":
+			stream.protocol, stream.codec, stream.unary = interact.ProtocolConnect, codecProto, true
+		case "application/json":
+			stream.protocol, stream.codec, stream.unary = interact.ProtocolConnect, codecJSON, true
+		default:
+			return nil, fmt.Errorf("unsupported content type: %s", mediaType)
+		}
+		if encoding := r.Header.Get("Content-Encoding"); stream.unary && encoding != "" && encoding != "identity" {
+			return nil, fmt.Errorf("unsupported content encoding: %s", encoding)
+		}
+		if stream.text {
+			data, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := decodeBase64Chunks(data)
+			if err != nil {
+				return nil, fmt.Errorf("invalid grpc-web-text body: %s", err)
+			}
+			stream.body = bytes.NewReader(decoded)
+		}
+		if value := r.Header.Get("Connect-Timeout-Ms"); value != "" {
+			if milliseconds, err := strconv.ParseInt(value, 10, 64); err == nil && milliseconds > 0 {
+				timeout = time.Duration(milliseconds) * time.Millisecond
+			}
+		}
+	}
+
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md.Append(strings.ToLower(key), values...)
+	}
+	if r.Host != "" {
+		md.Set(":authority", r.Host)
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	p := &peer.Peer{}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		p.Addr = addr
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	ctx = peer.NewContext(ctx, p)
+	if timeout > 0 {
+		stream.ctx, stream.cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		stream.ctx, stream.cancel = context.WithCancel(ctx)
+	}
+	stream.ctx = grpc.NewContextWithServerTransportStream(stream.ctx, &webTransportStream{
+		stream: stream,
+		method: r.URL.Path,
+	})
+	return stream, nil
+}
+
+// SetHeader implements the interface of grpc.ServerStream
+func (s *webStream) SetHeader(md metadata.MD) error {
+	if s.headerSent {
+		return errors.New("header has been sent")
+	}
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+// SendHeader implements the interface of grpc.ServerStream
+func (s *webStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	if !s.unary {
+		s.writeHeader(http.StatusOK)
+	}
+	return nil
+}
+
+// SetTrailer implements the interface of grpc.ServerStream
+func (s *webStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+// Context implements the interface of grpc.ServerStream
+func (s *webStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg implements the interface of grpc.ServerStream
+func (s *webStream) SendMsg(m interface{}) error {
+	message, ok := m.(interact.Message)
+	if !ok {
+		return fmt.Errorf("unexpected message type: %T", m)
+	}
+	data, err := message.Marshal()
+	if err != nil {
+		return err
+	}
+	if s.codec == codecJSON && s.method != nil {
+		response := dynamic.NewMessage(s.method.GetOutputType())
+		if err := response.Unmarshal(data); err != nil {
+			return err
+		}
+		data, err = response.MarshalJSONPB(&jsonpb.Marshaler{})
+		if err != nil {
+			return err
+		}
+	}
+	if s.unary {
+		s.unaryMessage = data
+		return nil
+	}
+	s.writeHeader(http.StatusOK)
+	return s.writeEnvelope(0, data)
+}
+
+// RecvMsg implements the interface of grpc.ServerStream
+func (s *webStream) RecvMsg(m interface{}) error {
+	message, ok := m.(*dynamic.Message)
+	if !ok {
+		return fmt.Errorf("unexpected message type: %T", m)
+	}
+	var data []byte
+	if s.unary {
+		if s.received {
+			return io.EOF
+		}
+		s.received = true
+		body, err := ioutil.ReadAll(s.body)
+		if err != nil {
+			return err
+		}
+		data = body
+	} else {
+		prefix := make([]byte, 5)
+		if _, err := io.ReadFull(s.body, prefix); err != nil {
+			if err == io.EOF {
+				return io.EOF
+			}
+			return status.Errorf(codes.InvalidArgument, "failed to read message: %s", err)
+		}
+		if prefix[0]&flagCompressed != 0 {
+			return status.Errorf(codes.Unimplemented, "compressed messages are not supported")
+		}
+		data = make([]byte, binary.BigEndian.Uint32(prefix[1:]))
+		if _, err := io.ReadFull(s.body, data); err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to read message: %s", err)
+		}
+	}
+	if s.codec == codecJSON {
+		if len(bytes.TrimSpace(data)) == 0 {
+			data = []byte("{}")
+		}
+		return message.UnmarshalJSONPB(&jsonpb.Unmarshaler{AllowUnknownFields: true}, data)
+	}
+	return message.Unmarshal(data)
+}
+
+// writeHeader is used to write the response headers once
+func (s *webStream) writeHeader(code int) {
+	if s.headerSent {
+		return
+	}
+	s.headerSent = true
+	header := s.w.Header()
+	var exposed []string
+	for key, values := range s.header {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+		exposed = append(exposed, key)
+	}
+	if s.unary {
+		for key, values := range s.trailer {
+			for _, value := range values {
+				header.Add("trailer-"+key, value)
+			}
+			exposed = append(exposed, "trailer-"+key)
+		}
+	}
+	if header.Get("Access-Control-Allow-Origin") != "" {
+		exposed = append(exposed, "grpc-status", "grpc-message")
+		sort.Strings(exposed)
+		header.Set("Access-Control-Expose-Headers", strings.Join(exposed, ", "))
+	}
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", s.contentType)
+	}
+	s.w.WriteHeader(code)
+}
+
+// writeEnvelope is used to write an enveloped message and flush it
+func (s *webStream) writeEnvelope(flags byte, data []byte) error {
+	frame := make([]byte, 5+len(data))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	copy(frame[5:], data)
+	if s.text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	if _, err := s.w.Write(frame); err != nil {
+		return err
+	}
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// finish is used to write the final status and trailers of the stream
+func (s *webStream) finish(err error) {
+	st := status.Convert(err)
+	switch {
+	case s.protocol == interact.ProtocolGRPCWeb:
+		s.writeHeader(http.StatusOK)
+		var trailer bytes.Buffer
+		fmt.Fprintf(&trailer, "grpc-status: %d\r\n", st.Code())
+		fmt.Fprintf(&trailer, "grpc-message: %s\r\n", encodeGRPCMessage(st.Message()))
+		if len(st.Proto().GetDetails()) > 0 {
+			data, err := proto.Marshal(st.Proto())
+			if err == nil {
+				fmt.Fprintf(&trailer, "grpc-status-details-bin: %s\r\n", base64.RawStdEncoding.EncodeToString(data))
+			}
+		}
+		for key, values := range s.trailer {
+			for _, value := range values {
+				fmt.Fprintf(&trailer, "%s: %s\r\n", strings.ToLower(key), value)
+			}
+		}
+		_ = s.writeEnvelope(flagGRPCWebTrailer, trailer.Bytes())
+	case s.unary:
+		if st.Code() == codes.OK {
+			s.writeHeader(http.StatusOK)
+			_, _ = s.w.Write(s.unaryMessage)
+			return
+		}
+		connectCode := connectCodes[st.Code()]
+		s.w.Header().Set("Content-Type", "application/json")
+		s.writeHeader(connectCode.httpStatus)
+		_ = json.NewEncoder(s.w).Encode(newConnectError(st))
+	default:
+		s.writeHeader(http.StatusOK)
+		endStream := map[string]interface{}{}
+		if st.Code() != codes.OK {
+			endStream["error"] = newConnectError(st)
+		}
+		if len(s.trailer) > 0 {
+			endStream["metadata"] = s.trailer
+		}
+		data, _ := json.Marshal(endStream)
+		_ = s.writeEnvelope(flagConnectEndStream, data)
+	}
+}
+
+// webTransportStream implements grpc.ServerTransportStream,
+// it is used to make grpc.MethodFromServerStream work with webStream
+type webTransportStream struct {
+	stream *webStream
+	method string
+}
+
+// Method implements the interface of grpc.ServerTransportStream
+func (t *webTransportStream) Method() string {
+	return t.method
+}
+
+// SetHeader implements the interface of grpc.ServerTransportStream
+func (t *webTransportStream) SetHeader(md metadata.MD) error {
+	return t.stream.SetHeader(md)
+}
+
+// SendHeader implements the interface of grpc.ServerTransportStream
+func (t *webTransportStream) SendHeader(md metadata.MD) error {
+	return t.stream.SendHeader(md)
+}
+
+// SetTrailer implements the interface of grpc.ServerTransportStream
+func (t *webTransportStream) SetTrailer(md metadata.MD) error {
+	t.stream.SetTrailer(md)
+	return nil
+}
+
+// newConnectError is used to convert status to the error structure of Connect protocol
+func newConnectError(st *status.Status) map[string]interface{} {
+	connectError := map[string]interface{}{
+		"code": connectCodes[st.Code()].name,
+	}
+	if st.Message() != "" {
+		connectError["message"] = st.Message()
+	}
+	var details []map[string]string
+	for _, detail := range st.Proto().GetDetails() {
+		details = append(details, map[string]string{
+			"type":  strings.TrimPrefix(detail.GetTypeUrl(), "type.googleapis.com/"),
+			"value": base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+		})
+	}
+	if len(details) > 0 {
+		connectError["details"] = details
+	}
+	return connectError
+}
+
+// decodeBase64Chunks is used to decode base64 data which may be concatenated by multiple padded chunks
+func decodeBase64Chunks(data []byte) ([]byte, error) {
+	var quantum []byte
+	var result []byte
+	for _, c := range data {
+		if c == '\r' || c == '\n' || c == ' ' {
+			continue
+		}
+		quantum = append(quantum, c)
+		if len(quantum) < 4 {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(string(quantum))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, decoded...)
+		quantum = quantum[:0]
+	}
+	if len(quantum) > 0 {
+		decoded, err := base64.RawStdEncoding.DecodeString(string(quantum))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, decoded...)
+	}
+	return result, nil
+}
+
+// encodeGRPCMessage is used to percent-encode grpc-message as the gRPC spec requires
+func encodeGRPCMessage(message string) string {
+	var builder strings.Builder
+	for i := 0; i < len(message); i++ {
+		c := message[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			builder.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&builder, "%%%02X", c)
+	}
+	return builder.String()
+}
@@ -0,0 +1,331 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// envelope is used to create an enveloped message of gRPC-Web and Connect
+func envelope(flags byte, data []byte) []byte {
+	frame := make([]byte, 5+len(data))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	copy(frame[5:], data)
+	return frame
+}
+
+func TestServeHTTP(t *testing.T) {
+	mockServer, _, service := newTestMockServer(t)
+	handler := mockServer.serveHTTP(grpc.NewServer())
+	newMessage := func(method string, input bool, name string) []byte {
+		md := service.FindMethodByName(method)
+		messageType := md.GetOutputType()
+		field := "message"
+		if input {
+			messageType, field = md.GetInputType(), "name"
+		}
+		message := dynamic.NewMessage(messageType)
+		message.SetFieldByName(field, name)
+		data, err := message.Marshal()
+		assert.Nil(t, err)
+		return data
+	}
+	request, reply := newMessage("Unary", true, "a"), newMessage("Unary", false, "a")
+	base64Text := func(frames ...[]byte) []byte {
+		var data []byte
+		for _, frame := range frames {
+			data = append(data, base64.StdEncoding.EncodeToString(frame)...)
+		}
+		return data
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        []byte
+		// expectations
+		status          int
+		expectType      string
+		expectBody      []byte
+		expectHeader    map[string]string
+		expectJSONError string
+	}{
+		{
+			name:         "connect unary json",
+			method:       http.MethodPost,
+			path:         "/test.Greeter/Unary",
+			contentType:  "application/json",
+			body:         []byte(`{"name": "a"}`),
+			status:       http.StatusOK,
+			expectType:   "application/json",
+			expectBody:   []byte(`{"message":"a"}`),
+			expectHeader: map[string]string{"X-Names": "a", "Trailer-X-Count": "1"},
+		},
+		{
+			name:        "connect unary proto",
+			method:      http.MethodPost,
+			path:        "/test.Greeter/Unary",
+			contentType: "application/proto",
+			body:        request,
+			status:      http.StatusOK,
+			expectType:  "application/proto",
+			expectBody:  reply,
+		},
+		{
+			name:       "connect unary get",
+			method:     http.MethodGet,
+			path:       "/test.Greeter/Unary?encoding=json&message=" + url.QueryEscape(`{"name":"a"}`),
+			status:     http.StatusOK,
+			expectType: "application/json",
+			expectBody: []byte(`{"message":"a"}`),
+		},
+		{
+			name:       "connect unary get with base64",
+			method:     http.MethodGet,
+			path:       "/test.Greeter/Unary?encoding=proto&base64=1&message=" + base64.RawURLEncoding.EncodeToString(request),
+			status:     http.StatusOK,
+			expectType: "application/proto",
+			expectBody: reply,
+		},
+		{
+			name:            "connect unary error",
+			method:          http.MethodPost,
+			path:            "/test.Greeter/Unary",
+			contentType:     "application/json",
+			body:            []byte(`{"name": "error"}`),
+			status:          http.StatusBadRequest,
+			expectType:      "application/json",
+			expectJSONError: `{"code":"invalid_argument","message":"expected code is: 3"}`,
+		},
+		{
+			name:            "connect unary method not found",
+			method:          http.MethodPost,
+			path:            "/test.Greeter/Unknown",
+			contentType:     "application/json",
+			body:            []byte(`{}`),
+			status:          http.StatusNotFound,
+			expectType:      "application/json",
+			expectJSONError: `{"code":"not_found","message":"method not found"}`,
+		},
+		{
+			name:        "connect server streaming json",
+			method:      http.MethodPost,
+			path:        "/test.Greeter/ServerStream",
+			contentType: "application/connect+json",
+			body:        envelope(0, []byte(`{"name": "a,b"}`)),
+			status:      http.StatusOK,
+			expectType:  "application/connect+json",
+			expectBody: bytes.Join([][]byte{
+				envelope(0, []byte(`{"message":"a"}`)),
+				envelope(0, []byte(`{"message":"b"}`)),
+				envelope(flagConnectEndStream, []byte(`{"metadata":{"x-count":["1"]}}`)),
+			}, nil),
+		},
+		{
+			name:        "grpc-web",
+			method:      http.MethodPost,
+			path:        "/test.Greeter/Unary",
+			contentType: "application/grpc-web+proto",
+			body:        envelope(0, request),
+			status:      http.StatusOK,
+			expectType:  "application/grpc-web+proto",
+			expectBody: bytes.Join([][]byte{
+				envelope(0, reply),
+				envelope(flagGRPCWebTrailer, []byte("grpc-status: 0\r\ngrpc-message: \r\nx-count: 1\r\n")),
+			}, nil),
+			expectHeader: map[string]string{"X-Names": "a"},
+		},
+		{
+			name:        "grpc-web error",
+			method:      http.MethodPost,
+			path:        "/test.Greeter/Unary",
+			contentType: "application/grpc-web",
+			body:        envelope(0, newMessage("Unary", true, "error")),
+			status:      http.StatusOK,
+			expectType:  "application/grpc-web",
+			expectBody:  envelope(flagGRPCWebTrailer, []byte("grpc-status: 3\r\ngrpc-message: expected code is: 3\r\nx-count: 1\r\n")),
+		},
+		{
+			name:        "grpc-web compressed",
+			method:      http.MethodPost,
+			path:        "/test.Greeter/Unary",
+			contentType: "application/grpc-web",
+			body:        envelope(flagCompressed, request),
+			status:      http.StatusOK,
+			expectType:  "application/grpc-web",
+			expectBody:  envelope(flagGRPCWebTrailer, []byte("grpc-status: 12\r\ngrpc-message: compressed messages are not supported\r\n")),
+		},
+		{
+			name:        "grpc-web-text",
+			method:      http.MethodPost,
+			path:        "/test.Greeter/Unary",
+			contentType: "application/grpc-web-text",
+			body:        base64Text(envelope(0, request)),
+			status:      http.StatusOK,
+			expectType:  "application/grpc-web-text",
+			expectBody: base64Text(
+				envelope(0, reply),
+				envelope(flagGRPCWebTrailer, []byte("grpc-status: 0\r\ngrpc-message: \r\nx-count: 1\r\n")),
+			),
+		},
+		{
+			name:        "unsupported content type",
+			method:      http.MethodPost,
+			path:        "/test.Greeter/Unary",
+			contentType: "text/plain",
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			name:   "unsupported encoding",
+			method: http.MethodGet,
+			path:   "/test.Greeter/Unary?encoding=xml",
+			status: http.StatusUnsupportedMediaType,
+		},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(test.method, test.path, bytes.NewReader(test.body))
+		if test.contentType != "" {
+			r.Header.Set("Content-Type", test.contentType)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, test.status, w.Code, test.name)
+		if test.expectType != "" {
+			assert.Equal(t, test.expectType, w.Header().Get("Content-Type"), test.name)
+		}
+		if test.expectBody != nil {
+			assert.Equal(t, test.expectBody, w.Body.Bytes(), test.name)
+		}
+		if test.expectJSONError != "" {
+			assert.JSONEq(t, test.expectJSONError, w.Body.String(), test.name)
+		}
+		for key, value := range test.expectHeader {
+			assert.Equal(t, value, w.Header().Get(key), test.name)
+		}
+	}
+}
+
+func TestServeHTTPWithCORS(t *testing.T) {
+	mockServer, _, _ := newTestMockServer(t)
+	handler := mockServer.serveHTTP(grpc.NewServer())
+
+	r := httptest.NewRequest(http.MethodOptions, "/test.Greeter/Unary", nil)
+	r.Header.Set("Origin", "http://example.com")
+	r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	r.Header.Set("Access-Control-Request-Headers", "content-type, x-user")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "http://example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "content-type, x-user", w.Header().Get("Access-Control-Allow-Headers"))
+
+	r = httptest.NewRequest(http.MethodPost, "/test.Greeter/Unary", bytes.NewReader([]byte(`{"name": "a"}`)))
+	r.Header.Set("Origin", "http://example.com")
+	r.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "http://example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "grpc-message, grpc-status, trailer-x-count, x-names", w.Header().Get("Access-Control-Expose-Headers"))
+}
+
+func TestDecodeBase64Chunks(t *testing.T) {
+	tests := []struct {
+		data   string
+		expect string
+		err    bool
+	}{
+		{data: "aGVsbG8=", expect: "hello"},
+		{data: "aGVsbG8", expect: "hello"},
+		{data: "aGVsbG8=d29ybGQ=", expect: "helloworld"},
+		{data: "aGVs\r\nbG8=", expect: "hello"},
+		{data: "", expect: ""},
+		{data: "!!!!", err: true},
+	}
+	for _, test := range tests {
+		decoded, err := decodeBase64Chunks([]byte(test.data))
+		assert.Equal(t, test.err, err != nil, test.data)
+		assert.Equal(t, test.expect, string(decoded), test.data)
+	}
+}
+
+func TestEncodeGRPCMessage(t *testing.T) {
+	tests := []struct {
+		message string
+		expect  string
+	}{
+		{message: "hello world", expect: "hello world"},
+		{message: "100%", expect: "100%25"},
+		{message: "a\r\nb", expect: "a%0D%0Ab"},
+		{message: "你", expect: "%E4%BD%A0"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expect, encodeGRPCMessage(test.message), test.message)
+	}
+}
+
+func TestNewConnectError(t *testing.T) {
+	detail, err := anypb.New(&errdetails.ErrorInfo{Reason: "NOT_FOUND"})
+	assert.Nil(t, err)
+	tests := []struct {
+		name   string
+		status *spb.Status
+		expect map[string]interface{}
+	}{
+		{
+			name:   "code",
+			status: &spb.Status{Code: int32(codes.NotFound)},
+			expect: map[string]interface{}{"code": "not_found"},
+		},
+		{
+			name:   "message",
+			status: &spb.Status{Code: int32(codes.InvalidArgument), Message: "invalid"},
+			expect: map[string]interface{}{"code": "invalid_argument", "message": "invalid"},
+		},
+		{
+			name:   "details",
+			status: &spb.Status{Code: int32(codes.NotFound), Message: "not found", Details: []*anypb.Any{detail}},
+			expect: map[string]interface{}{
+				"code":    "not_found",
+				"message": "not found",
+				"details": []map[string]string{{
+					"type":  "google.rpc.ErrorInfo",
+					"value": base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+				}},
+			},
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expect, newConnectError(status.FromProto(test.status)), test.name)
+	}
+}
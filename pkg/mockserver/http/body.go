@@ -0,0 +1,225 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/jhump/protoreflect/dynamic"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+// maxMultipartMemory is the maximum bytes of multipart files stored in memory
+const maxMultipartMemory = 32 << 20
+
+// parseBody is used to parse body according to the content type,
+// so that it can be accessed as $request.body in conditions and templates
+// The raw data is kept, and bodies which are not JSON are encoded to JSON as:
+// - form: {"field": "value"}, values are arrays if the field is repeated
+// - multipart: fields as form, and files as {"filename": "", "size": 0, "contentType": ""}
+// - xml: {"root": {"@attr": "", "child": "text", "#text": ""}}, repeated elements are arrays
+// - protobuf: the JSON format of message specified by the messageType (or proto) parameter
+// - others: the body as a JSON string
+func (s *MockServer) parseBody(contentType string, body []byte) interact.Message {
+	if len(body) == 0 {
+		return interact.NewBytesMessage(body)
+	}
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	var parsed interface{}
+	var err error
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return interact.NewBytesMessage(body)
+	case mediaType == "application/x-www-form-urlencoded":
+		parsed, err = parseForm(body)
+	case mediaType == "multipart/form-data":
+		parsed, err = parseMultipart(body, params["boundary"])
+	case mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml"):
+		parsed, err = parseXML(body)
+	case mediaType == "application/x-protobuf" || mediaType == "application/protobuf":
+		messageType := params["messagetype"]
+		if messageType == "" {
+			messageType = params["proto"]
+		}
+		var data []byte
+		data, err = s.parseProtobuf(body, messageType)
+		if err == nil {
+			return interact.NewParsedMessage(body, data)
+		}
+	default:
+		if json.Valid(body) {
+			return interact.NewBytesMessage(body)
+		}
+	}
+	if err != nil {
+		s.LogWarn(map[string]interface{}{
+			"contentType": contentType,
+		}, "failed to parse body: %s", err)
+		parsed = nil
+	}
+	if parsed == nil {
+		parsed = string(body)
+	}
+	data, err := json.Marshal(parsed)
+	if err != nil {
+		return interact.NewBytesMessage(body)
+	}
+	return interact.NewParsedMessage(body, data)
+}
+
+// parseProtobuf is used to decode the binary body by the loaded message descriptor
+func (s *MockServer) parseProtobuf(body []byte, messageType string) ([]byte, error) {
+	if messageType == "" {
+		return nil, errors.New("messageType of protobuf is not specified")
+	}
+	if s.protoManager == nil {
+		return nil, errors.New("proto manager is unavailable")
+	}
+	descriptor, ok := s.protoManager.GetMessage(messageType)
+	if !ok {
+		return nil, fmt.Errorf("unable to find message: %s", messageType)
+	}
+	message := dynamic.NewMessage(descriptor)
+	if err := message.Unmarshal(body); err != nil {
+		return nil, err
+	}
+	return message.MarshalJSONPB(&jsonpb.Marshaler{})
+}
+
+func parseForm(body []byte) (interface{}, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	form := map[string]interface{}{}
+	for key, val := range values {
+		form[key] = singleOrList(val)
+	}
+	return form, nil
+}
+
+func parseMultipart(body []byte, boundary string) (interface{}, error) {
+	if boundary == "" {
+		return nil, errors.New("boundary of multipart is not specified")
+	}
+	multipartForm, err := multipart.NewReader(bytes.NewReader(body), boundary).ReadForm(maxMultipartMemory)
+	if err != nil {
+		return nil, err
+	}
+	defer multipartForm.RemoveAll()
+	form := map[string]interface{}{}
+	for key, val := range multipartForm.Value {
+		form[key] = singleOrList(val)
+	}
+	for key, headers := range multipartForm.File {
+		var files []interface{}
+		for _, header := range headers {
+			files = append(files, map[string]interface{}{
+				"filename":    header.Filename,
+				"size":        header.Size,
+				"contentType": header.Header.Get("Content-Type"),
+			})
+		}
+		if len(files) == 1 {
+			form[key] = files[0]
+		} else {
+			form[key] = files
+		}
+	}
+	return form, nil
+}
+
+func singleOrList(values []string) interface{} {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
+
+// parseXML is used to convert XML to a tree of maps which can be accessed like XPath,
+// such as order.item.0.@id
+func parseXML(body []byte) (interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, errors.New("no element found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			element, err := parseXMLElement(decoder, start)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{start.Name.Local: element}, nil
+		}
+	}
+}
+
+// parseXMLElement is used to parse an element,
+// it returns the text if the element contains neither attributes nor children
+func parseXMLElement(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	element := map[string]interface{}{}
+	for _, attr := range start.Attr {
+		element["@"+attr.Name.Local] = attr.Value
+	}
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := parseXMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing := element[name].(type) {
+			case nil:
+				element[name] = child
+			case []interface{}:
+				element[name] = append(existing, child)
+			default:
+				element[name] = []interface{}{existing, child}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(element) == 0 {
+				return content, nil
+			}
+			if content != "" {
+				element["#text"] = content
+			}
+			return element, nil
+		}
+	}
+}
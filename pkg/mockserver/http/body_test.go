@@ -0,0 +1,166 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// fakeProtoManager is a proto manager with the given messages
+type fakeProtoManager struct {
+	protomanager.Provider
+	messages map[string]*desc.MessageDescriptor
+}
+
+func (m *fakeProtoManager) GetMessage(name string) (*desc.MessageDescriptor, bool) {
+	message, ok := m.messages[name]
+	return message, ok
+}
+
+func TestParseBody(t *testing.T) {
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"user.proto": `syntax = "proto3"; package test; message User { string name = 1; int32 age = 2; }`,
+		}),
+	}
+	fds, err := parser.ParseFiles("user.proto")
+	assert.Nil(t, err)
+	user := dynamic.NewMessage(fds[0].FindMessage("test.User"))
+	user.SetFieldByName("name", "powermock")
+	user.SetFieldByName("age", int32(3))
+	protobuf, err := user.Marshal()
+	assert.Nil(t, err)
+
+	var multipartBody bytes.Buffer
+	writer := multipart.NewWriter(&multipartBody)
+	assert.Nil(t, writer.WriteField("name", "powermock"))
+	assert.Nil(t, writer.WriteField("tag", "a"))
+	assert.Nil(t, writer.WriteField("tag", "b"))
+	file, err := writer.CreateFormFile("avatar", "avatar.png")
+	assert.Nil(t, err)
+	_, err = file.Write([]byte("png"))
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+
+	multipartString, err := json.Marshal(multipartBody.String())
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		expect      string
+	}{
+		{
+			name:        "json",
+			contentType: "application/json",
+			body:        []byte(`{"name": "powermock"}`),
+			expect:      `{"name": "powermock"}`,
+		},
+		{
+			name:        "json suffix",
+			contentType: "application/vnd.api+json; charset=utf-8",
+			body:        []byte(`{"name": "powermock"}`),
+			expect:      `{"name": "powermock"}`,
+		},
+		{
+			name:        "json without content type",
+			contentType: "",
+			body:        []byte(`[1, 2]`),
+			expect:      `[1, 2]`,
+		},
+		{
+			name:        "form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        []byte("name=powermock&tag=a&tag=b"),
+			expect:      `{"name": "powermock", "tag": ["a", "b"]}`,
+		},
+		{
+			name:        "multipart",
+			contentType: writer.FormDataContentType(),
+			body:        multipartBody.Bytes(),
+			expect: `{"name": "powermock", "tag": ["a", "b"],
+				"avatar": {"filename": "avatar.png", "size": 3, "contentType": "application/octet-stream"}}`,
+		},
+		{
+			name:        "multipart without boundary",
+			contentType: "multipart/form-data",
+			body:        multipartBody.Bytes(),
+			expect:      string(multipartString),
+		},
+		{
+			name:        "xml",
+			contentType: "application/xml",
+			body: []byte(`<?xml version="1.0"?><order id="1">
+				<item sku="a">apple</item><item sku="b"/><note>fresh</note>mixed</order>`),
+			expect: `{"order": {"@id": "1", "item": [{"@sku": "a", "#text": "apple"}, {"@sku": "b"}], "note": "fresh", "#text": "mixed"}}`,
+		},
+		{
+			name:        "invalid xml",
+			contentType: "text/xml",
+			body:        []byte(`<order>`),
+			expect:      `"<order>"`,
+		},
+		{
+			name:        "protobuf",
+			contentType: "application/x-protobuf; messageType=test.User",
+			body:        protobuf,
+			expect:      `{"name": "powermock", "age": 3}`,
+		},
+		{
+			name:        "protobuf with proto parameter",
+			contentType: "application/protobuf; proto=test.User",
+			body:        protobuf,
+			expect:      `{"name": "powermock", "age": 3}`,
+		},
+		{
+			name:        "protobuf of unknown type",
+			contentType: "application/x-protobuf; messageType=test.Unknown",
+			body:        []byte("raw"),
+			expect:      `"raw"`,
+		},
+		{
+			name:        "text",
+			contentType: "text/plain",
+			body:        []byte("hello"),
+			expect:      `"hello"`,
+		},
+	}
+	s := &MockServer{
+		protoManager: &fakeProtoManager{messages: map[string]*desc.MessageDescriptor{"test.User": fds[0].FindMessage("test.User")}},
+		Logger:       logger.NewDefault("test"),
+	}
+	for _, test := range tests {
+		message := s.parseBody(test.contentType, test.body)
+		assert.Equal(t, test.body, message.Bytes(), test.name)
+		data, err := message.MarshalJSON()
+		assert.Nil(t, err, test.name)
+		assert.JSONEq(t, test.expect, string(data), test.name)
+	}
+
+	message := s.parseBody("text/plain", nil)
+	assert.Empty(t, message.Bytes())
+}
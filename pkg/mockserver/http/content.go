@@ -0,0 +1,69 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+// writeContent is used to write the binary content of response without loading it into memory
+// The content length and content type are set, and range requests are supported if the code is 200
+func (s *MockServer) writeContent(w http.ResponseWriter, r *http.Request, resp *interact.Response) {
+	reader, err := resp.Content.Open()
+	if err != nil {
+		sendError(w, http.StatusInternalServerError, err)
+		return
+	}
+	defer reader.Close()
+	code := int(resp.Code)
+	if code < 100 || code > 999 || code == http.StatusOK {
+		http.ServeContent(w, r, resp.Content.Name, resp.Content.ModTime, reader)
+		return
+	}
+
+	// http.ServeContent always responds with 200 or 206, so the content is copied for the other codes
+	size, err := reader.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = reader.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		sendError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if w.Header().Get("Content-Type") == "" {
+		contentType := mime.TypeByExtension(filepath.Ext(resp.Content.Name))
+		if contentType == "" {
+			var buf [512]byte
+			n, _ := io.ReadFull(reader, buf[:])
+			contentType = http.DetectContentType(buf[:n])
+			if _, err := reader.Seek(0, io.SeekStart); err != nil {
+				sendError(w, http.StatusInternalServerError, err)
+				return
+			}
+		}
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(code)
+	if _, err := io.Copy(w, reader); err != nil {
+		s.LogWarn(nil, "failed to write content: %s", err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func TestWriteContent(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0123456789")
+	tests := []struct {
+		name        string
+		code        uint32
+		header      http.Header
+		content     *interact.Content
+		rangeHeader string
+		expectCode  int
+		expectType  string
+		expectBody  string
+	}{
+		{
+			name:       "ok",
+			code:       http.StatusOK,
+			content:    interact.NewBytesContent("a.txt", []byte("hello")),
+			expectCode: http.StatusOK,
+			expectType: "text/plain; charset=utf-8",
+			expectBody: "hello",
+		},
+		{
+			name:       "default code",
+			content:    interact.NewBytesContent("a.json", []byte(`{}`)),
+			expectCode: http.StatusOK,
+			expectType: "application/json",
+			expectBody: `{}`,
+		},
+		{
+			name:        "range",
+			code:        http.StatusOK,
+			content:     interact.NewBytesContent("a.txt", []byte("hello")),
+			rangeHeader: "bytes=1-3",
+			expectCode:  http.StatusPartialContent,
+			expectType:  "text/plain; charset=utf-8",
+			expectBody:  "ell",
+		},
+		{
+			name:       "code",
+			code:       http.StatusNotFound,
+			content:    interact.NewBytesContent("a.txt", []byte("hello")),
+			expectCode: http.StatusNotFound,
+			expectType: "text/plain; charset=utf-8",
+			expectBody: "hello",
+		},
+		{
+			name:        "range is ignored with code",
+			code:        http.StatusCreated,
+			content:     interact.NewBytesContent("a.txt", []byte("hello")),
+			rangeHeader: "bytes=1-3",
+			expectCode:  http.StatusCreated,
+			expectType:  "text/plain; charset=utf-8",
+			expectBody:  "hello",
+		},
+		{
+			name:       "detect content type",
+			code:       http.StatusAccepted,
+			content:    interact.NewBytesContent("", png),
+			expectCode: http.StatusAccepted,
+			expectType: "image/png",
+			expectBody: string(png),
+		},
+		{
+			name:       "content type of header",
+			code:       http.StatusAccepted,
+			header:     http.Header{"Content-Type": []string{"application/octet-stream"}},
+			content:    interact.NewBytesContent("", png),
+			expectCode: http.StatusAccepted,
+			expectType: "application/octet-stream",
+			expectBody: string(png),
+		},
+		{
+			name: "failed to open",
+			code: http.StatusOK,
+			content: &interact.Content{Open: func() (io.ReadSeekCloser, error) {
+				return nil, errors.New("failed")
+			}},
+			expectCode: http.StatusInternalServerError,
+		},
+	}
+	s := &MockServer{Logger: logger.NewDefault("test")}
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		if test.rangeHeader != "" {
+			request.Header.Set("Range", test.rangeHeader)
+		}
+		recorder := httptest.NewRecorder()
+		for key, values := range test.header {
+			recorder.Header()[key] = values
+		}
+		s.writeContent(recorder, request, &interact.Response{Code: test.code, Content: test.content})
+		assert.Equal(t, test.expectCode, recorder.Code, test.name)
+		if test.expectCode == http.StatusInternalServerError {
+			continue
+		}
+		assert.Equal(t, test.expectType, recorder.Header().Get("Content-Type"), test.name)
+		assert.Equal(t, test.expectBody, recorder.Body.String(), test.name)
+	}
+}
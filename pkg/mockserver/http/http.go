@@ -20,27 +20,40 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
-	"strings"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/quic-go/quic-go/http3"
 	"github.com/spf13/pflag"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 
 	"github.com/bilibili-base/powermock/pkg/apimanager"
 	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
 	"github.com/bilibili-base/powermock/pkg/util"
 	"github.com/bilibili-base/powermock/pkg/util/logger"
+	"github.com/bilibili-base/powermock/pkg/util/tlsconfig"
 )
 
 // Provider defines the mock server interface
 type Provider interface {
 	Start(ctx context.Context, cancelFunc context.CancelFunc) error
+	// SetProtoManager is used to set the proto manager to parse protobuf bodies,
+	// it must be called before Start
+	SetProtoManager(protoManager protomanager.Provider)
 }
 
 // MockServer is the implement of http mock server
 type MockServer struct {
 	cfg        *Config
 	apiManager apimanager.Provider
-	registerer prometheus.Registerer
+	// optional, it is nil when HTTP/3 is disabled
+	http3Server *http3.Server
+	// optional, it is nil when the gRPC mock server is disabled
+	protoManager protomanager.Provider
+	registerer   prometheus.Registerer
 	logger.Logger
 }
 
@@ -48,13 +61,21 @@ type MockServer struct {
 type Config struct {
 	Enable  bool
 	Address string
+	// EnableH2C is used to serve cleartext HTTP/2 when TLS is disabled
+	EnableH2C bool
+	// EnableHTTP3 is used to serve HTTP/3 on the UDP port of Address, it requires TLS
+	EnableHTTP3 bool
+	TLS         *tlsconfig.Config
 }
 
 // NewConfig is used to init config with default values
 func NewConfig() *Config {
 	return &Config{
-		Enable:  true,
-		Address: "0.0.0.0:30003",
+		Enable:      true,
+		Address:     "0.0.0.0:30003",
+		EnableH2C:   true,
+		EnableHTTP3: false,
+		TLS:         tlsconfig.NewConfig(),
 	}
 }
 
@@ -68,6 +89,9 @@ func (c *Config) IsEnabled() bool {
 func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
 	f.BoolVar(&c.Enable, prefix+"httpMockServer.enable", c.Enable, "define whether the component is enabled")
 	f.StringVar(&c.Address, prefix+"httpMockServer.address", c.Address, "address to listen")
+	f.BoolVar(&c.EnableH2C, prefix+"httpMockServer.enableH2C", c.EnableH2C, "define whether to serve cleartext HTTP/2 when TLS is disabled")
+	f.BoolVar(&c.EnableHTTP3, prefix+"httpMockServer.enableHTTP3", c.EnableHTTP3, "define whether to serve HTTP/3, it requires TLS")
+	c.TLS.RegisterFlagsWithPrefix(prefix+"httpMockServer.", f)
 }
 
 // Validate is used to validate config and returns error on failure
@@ -75,7 +99,10 @@ func (c *Config) Validate() error {
 	if c.Address == "" {
 		return errors.New("the address of mockserver is required")
 	}
-	return nil
+	if c.EnableHTTP3 && !c.TLS.IsEnabled() {
+		return errors.New("TLS is required to serve HTTP/3")
+	}
+	return c.TLS.Validate()
 }
 
 // New is used to init service
@@ -99,30 +126,66 @@ func (s *MockServer) ServeHTTP(w http.ResponseWriter, request *http.Request) {
 		"headers": request.Header,
 		"method":  request.Method,
 	}, "request received")
+	if s.http3Server != nil && request.ProtoMajor < 3 {
+		_ = s.http3Server.SetQuicHeaders(w.Header())
+	}
 	body, err := ioutil.ReadAll(request.Body)
 	if err != nil {
 		sendError(w, http.StatusInternalServerError, err)
 		return
 	}
-	resp, err := s.apiManager.MockResponse(request.Context(), &interact.Request{
-		Protocol: interact.ProtocolHTTP,
-		Method:   request.Method,
-		Host:     request.Host,
-		Path:     request.URL.Path,
-		Header:   getHeadersFromHttpHeaders(request.Header),
-		Body:     interact.NewBytesMessage(body),
-	})
+	req := newRequest(request, nil)
+	req.Body = s.parseBody(request.Header.Get("Content-Type"), body)
+	var resp *interact.Response
+	if websocket.IsWebSocketUpgrade(request) {
+		// the upgrade request is served as a normal http request unless the matched case responds with WebSocketResponse
+		req.Protocol = interact.ProtocolWebSocket
+		mockCase, err := s.apiManager.MatchCase(request.Context(), req)
+		if err != nil {
+			sendError(w, util.GetHTTPCodeFromError(err), err)
+			return
+		}
+		if mock := mockCase.GetResponse().GetWebsocket(); mock != nil {
+			s.serveWebSocket(w, request, req, mock)
+			return
+		}
+		resp, err = s.apiManager.GenerateResponse(request.Context(), mockCase.GetResponse(), req)
+	} else {
+		resp, err = s.apiManager.MockResponse(request.Context(), req)
+	}
 	if err != nil {
 		sendError(w, util.GetHTTPCodeFromError(err), err)
 		return
 	}
-	if code := resp.Code; code >= 100 && code <= 999 {
-		w.WriteHeader(int(resp.Code))
+	for key, values := range resp.Header {
+		for _, val := range values {
+			w.Header().Add(key, val)
+		}
 	}
-	for key, val := range resp.Header {
-		w.Header().Set(key, val)
+	// trailers must be declared before writing the header
+	for key := range resp.Trailer {
+		w.Header().Add("Trailer", key)
+	}
+	if resp.Content != nil {
+		s.writeContent(w, request, resp)
+	} else if len(resp.Stream) > 0 {
+		s.writeStream(request.Context(), w, resp)
+	} else {
+		if code := resp.Code; code >= 100 && code <= 999 {
+			w.WriteHeader(int(resp.Code))
+		}
+		w.Write(resp.Body.Bytes())
+	}
+	for key, values := range resp.Trailer {
+		for _, val := range values {
+			w.Header().Add(key, val)
+		}
 	}
-	w.Write(resp.Body.Bytes())
+}
+
+// SetProtoManager is used to set the proto manager to parse protobuf bodies
+func (s *MockServer) SetProtoManager(protoManager protomanager.Provider) {
+	s.protoManager = protoManager
 }
 
 // Start is used to start the service
@@ -132,14 +195,75 @@ func (s *MockServer) Start(ctx context.Context, cancelFunc context.CancelFunc) e
 	if err != nil {
 		return err
 	}
+	server := &http.Server{
+		Handler: s,
+	}
+	serve := func() error {
+		return server.Serve(listener)
+	}
+	if s.cfg.TLS.IsEnabled() {
+		tlsManager, err := tlsconfig.New(s.cfg.TLS, s.Logger)
+		if err != nil {
+			return err
+		}
+		server.TLSConfig = tlsManager.TLSConfig("h2", "http/1.1")
+		serve = func() error {
+			return server.ServeTLS(listener, "", "")
+		}
+		if s.cfg.EnableHTTP3 {
+			s.startHTTP3Server(ctx, cancelFunc, tlsManager)
+		}
+	} else if s.cfg.EnableH2C {
+		server.Handler = h2c.NewHandler(s, &http2.Server{})
+	}
 	util.StartServiceAsync(ctx, cancelFunc, s.Logger, func() error {
-		return http.Serve(listener, s)
+		if err := serve(); err != http.ErrServerClosed {
+			return err
+		}
+		return nil
 	}, func() error {
-		return listener.Close()
+		return server.Shutdown(context.TODO())
 	})
 	return nil
 }
 
+// startHTTP3Server is used to serve HTTP/3 on the UDP port of the same address
+func (s *MockServer) startHTTP3Server(ctx context.Context, cancelFunc context.CancelFunc, tlsManager *tlsconfig.Manager) {
+	s.LogInfo(nil, "starting http/3 mock server on: %s", s.cfg.Address)
+	s.http3Server = &http3.Server{
+		Addr:      s.cfg.Address,
+		Handler:   s,
+		TLSConfig: tlsManager.TLSConfig(),
+	}
+	util.StartServiceAsync(ctx, cancelFunc, s.Logger.NewLogger("http3"), func() error {
+		if err := s.http3Server.ListenAndServe(); err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	}, func() error {
+		return s.http3Server.Close()
+	})
+}
+
+// newRequest is used to convert http.Request to interact.Request
+func newRequest(request *http.Request, body []byte) *interact.Request {
+	req := &interact.Request{
+		Protocol:  interact.ProtocolHTTP,
+		Proto:     request.Proto,
+		Method:    request.Method,
+		Host:      request.Host,
+		Path:      request.URL.Path,
+		Header:    interact.NewValues(request.Header),
+		Body:      interact.NewBytesMessage(body),
+		Query:     request.URL.Query(),
+		Cookies:   getCookies(request),
+		TLS:       interact.NewTLSInfo(request.TLS),
+		Timestamp: time.Now(),
+	}
+	req.SetRemoteAddr(request.RemoteAddr)
+	return req
+}
+
 func sendError(w http.ResponseWriter, code int, err error) {
 	w.WriteHeader(code)
 	if err != nil {
@@ -147,14 +271,13 @@ func sendError(w http.ResponseWriter, code int, err error) {
 	}
 }
 
-// getHeadersFromHttpHeaders is used to get map[string]string from http.Header
-func getHeadersFromHttpHeaders(input http.Header) map[string]string {
-	headers := map[string]string{}
-	for key, values := range input {
-		key = strings.ToLower(key)
-		if len(values) > 0 {
-			headers[key] = values[0]
+// getCookies is used to get cookies of request, the first one is kept if the name is duplicated
+func getCookies(request *http.Request) map[string]string {
+	cookies := map[string]string{}
+	for _, cookie := range request.Cookies() {
+		if _, ok := cookies[cookie.Name]; !ok {
+			cookies[cookie.Name] = cookie.Value
 		}
 	}
-	return headers
+	return cookies
 }
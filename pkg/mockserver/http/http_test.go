@@ -0,0 +1,264 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/apimanager"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+	"github.com/bilibili-base/powermock/pkg/util/tlsconfig"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   func(cfg *Config)
+		valid bool
+	}{
+		{name: "default", cfg: func(cfg *Config) {}, valid: true},
+		{name: "no address", cfg: func(cfg *Config) { cfg.Address = "" }, valid: false},
+		{name: "http3 without tls", cfg: func(cfg *Config) { cfg.EnableHTTP3 = true }, valid: false},
+		{name: "http3 with tls", cfg: func(cfg *Config) {
+			cfg.EnableHTTP3 = true
+			cfg.TLS = &tlsconfig.Config{Enable: true, AutoGenerate: true}
+		}, valid: true},
+		{name: "invalid tls", cfg: func(cfg *Config) { cfg.TLS = &tlsconfig.Config{Enable: true} }, valid: false},
+	}
+	for _, test := range tests {
+		cfg := NewConfig()
+		test.cfg(cfg)
+		assert.Equal(t, test.valid, cfg.Validate() == nil, test.name)
+	}
+}
+
+// getFreeAddress is used to get an address whose TCP and UDP ports are both free
+func getFreeAddress(t *testing.T) string {
+	for {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.Nil(t, err)
+		address := listener.Addr().String()
+		_ = listener.Close()
+		conn, err := net.ListenPacket("udp", address)
+		if err != nil {
+			continue
+		}
+		_ = conn.Close()
+		return address
+	}
+}
+
+// startTestMockServer is used to start the mock server with cfg and wait until it is serving
+func startTestMockServer(t *testing.T, cfg func(cfg *Config)) (*fakeAPIManager, string) {
+	config := NewConfig()
+	config.Address = getFreeAddress(t)
+	cfg(config)
+	apiManager := &fakeAPIManager{
+		mockCase: &v1alpha1.MockAPI_Case{
+			Response: &v1alpha1.MockAPI_Response{
+				Response: &v1alpha1.MockAPI_Response_Simple{Simple: &v1alpha1.MockAPI_Response_SimpleResponse{Body: "hello"}},
+			},
+		},
+	}
+	server, err := New(config, apiManager, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	assert.Nil(t, server.Start(ctx, cancel))
+	for i := 0; i < 50; i++ {
+		conn, err := net.Dial("tcp", config.Address)
+		if err == nil {
+			_ = conn.Close()
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return apiManager, config.Address
+}
+
+func TestProtocols(t *testing.T) {
+	insecureTLS := &tls.Config{InsecureSkipVerify: true}
+	h2cTransport := &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return net.Dial(network, addr)
+		},
+	}
+	tlsConfig := func(cfg *Config) {
+		cfg.TLS = &tlsconfig.Config{Enable: true, AutoGenerate: true}
+	}
+	tests := []struct {
+		name      string
+		cfg       func(cfg *Config)
+		scheme    string
+		transport http.RoundTripper
+		proto     string
+		altSvc    bool
+		fail      bool
+	}{
+		{
+			name:      "http/1.1",
+			cfg:       func(cfg *Config) {},
+			scheme:    "http",
+			transport: &http.Transport{},
+			proto:     "HTTP/1.1",
+		},
+		{
+			name:      "h2c",
+			cfg:       func(cfg *Config) {},
+			scheme:    "http",
+			transport: h2cTransport,
+			proto:     "HTTP/2.0",
+		},
+		{
+			name:      "h2c disabled",
+			cfg:       func(cfg *Config) { cfg.EnableH2C = false },
+			scheme:    "http",
+			transport: h2cTransport,
+			fail:      true,
+		},
+		{
+			name:      "h2",
+			cfg:       tlsConfig,
+			scheme:    "https",
+			transport: &http.Transport{TLSClientConfig: insecureTLS, ForceAttemptHTTP2: true},
+			proto:     "HTTP/2.0",
+		},
+		{
+			name: "h2 with http3",
+			cfg: func(cfg *Config) {
+				tlsConfig(cfg)
+				cfg.EnableHTTP3 = true
+			},
+			scheme:    "https",
+			transport: &http.Transport{TLSClientConfig: insecureTLS, ForceAttemptHTTP2: true},
+			proto:     "HTTP/2.0",
+			altSvc:    true,
+		},
+		{
+			name: "http3",
+			cfg: func(cfg *Config) {
+				tlsConfig(cfg)
+				cfg.EnableHTTP3 = true
+			},
+			scheme:    "https",
+			transport: &http3.RoundTripper{TLSClientConfig: insecureTLS},
+			proto:     "HTTP/3.0",
+		},
+	}
+	for _, test := range tests {
+		apiManager, address := startTestMockServer(t, test.cfg)
+		client := &http.Client{Transport: test.transport, Timeout: 5 * time.Second}
+		resp, err := client.Get(test.scheme + "://" + address + "/hello")
+		if test.fail {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		if !assert.Nil(t, err, test.name) {
+			continue
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, "hello", string(body), test.name)
+		assert.Equal(t, test.altSvc, resp.Header.Get("Alt-Svc") != "", test.name)
+		if assert.Len(t, apiManager.requests, 1, test.name) {
+			assert.Equal(t, test.proto, apiManager.requests[0].Proto, test.name)
+		}
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		url        string
+		remoteAddr string
+		query      map[string][]string
+		ip         string
+	}{
+		{url: "/hello", remoteAddr: "192.0.2.1:1234", query: map[string][]string{}, ip: "192.0.2.1"},
+		{url: "/hello?a=1&a=2&b=", remoteAddr: "[2001:db8::1]:1234", query: map[string][]string{"a": {"1", "2"}, "b": {""}}, ip: "2001:db8::1"},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, test.url, nil)
+		r.RemoteAddr = test.remoteAddr
+		before := time.Now()
+		request := newRequest(r, nil)
+		assert.Equal(t, "/hello", request.Path, test.url)
+		assert.Equal(t, test.query, request.Query, test.url)
+		assert.Equal(t, test.remoteAddr, request.RemoteAddr, test.url)
+		assert.Equal(t, test.ip, request.IP, test.url)
+		assert.False(t, request.Timestamp.Before(before), test.url)
+		assert.Nil(t, request.TLS, test.url)
+	}
+}
+
+// staticAPIManager responds with the same response to every request
+type staticAPIManager struct {
+	apimanager.Provider
+	response *interact.Response
+}
+
+func (m *staticAPIManager) MockResponse(ctx context.Context, request *interact.Request) (*interact.Response, error) {
+	return m.response, nil
+}
+
+func TestServeHTTPHeadersAndTrailers(t *testing.T) {
+	server := httptest.NewServer(&MockServer{
+		cfg: NewConfig(),
+		apiManager: &staticAPIManager{response: &interact.Response{
+			Code: http.StatusCreated,
+			Header: interact.Values{
+				"set-cookie": []string{"session=powermock; Path=/; HttpOnly", "theme=dark"},
+				"x-tag":      []string{"a", "b"},
+			},
+			Trailer: interact.Values{"x-checksum": []string{"sum"}, "x-step": []string{"1", "2"}},
+			Body:    interact.NewBytesMessage([]byte("hello")),
+		}},
+		Logger: logger.NewDefault("test"),
+	})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/hello")
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, []string{"session=powermock; Path=/; HttpOnly", "theme=dark"}, resp.Header.Values("Set-Cookie"))
+	var cookies []string
+	for _, cookie := range resp.Cookies() {
+		cookies = append(cookies, cookie.Name+"="+cookie.Value)
+	}
+	assert.Equal(t, []string{"session=powermock", "theme=dark"}, cookies)
+	assert.Equal(t, []string{"a", "b"}, resp.Header.Values("X-Tag"))
+	// the announced trailers are known before the body is read, and their values are known after it
+	assert.Equal(t, http.Header{"X-Checksum": nil, "X-Step": nil}, resp.Trailer)
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "hello", string(body))
+	assert.Equal(t, http.Header{"X-Checksum": {"sum"}, "X-Step": {"1", "2"}}, resp.Trailer)
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+// writeStream is used to write the chunks of response one by one and flush after each of them
+// Chunks are written as Server-Sent Events if the content-type of response is text/event-stream
+func (s *MockServer) writeStream(ctx context.Context, w http.ResponseWriter, resp *interact.Response) {
+	sse := strings.HasPrefix(strings.ToLower(w.Header().Get("Content-Type")), "text/event-stream")
+	if sse && w.Header().Get("Cache-Control") == "" {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	code := http.StatusOK
+	if c := resp.Code; c >= 100 && c <= 999 {
+		code = int(c)
+	}
+	w.WriteHeader(code)
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		flusher.Flush()
+	}
+	for _, message := range resp.Stream {
+		if err := wait(ctx, time.Duration(message.Delay)*time.Millisecond); err != nil {
+			return
+		}
+		var data []byte
+		if message.Body != nil {
+			data = message.Body.Bytes()
+		}
+		if sse {
+			data = formatEvent(message.ID, message.Event, data)
+		}
+		if _, err := w.Write(data); err != nil {
+			s.LogWarn(nil, "failed to write chunk: %s", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// formatEvent is used to format the message as an event of Server-Sent Events,
+// each line of data is sent as a data field
+func formatEvent(id, event string, data []byte) []byte {
+	var buf bytes.Buffer
+	if id != "" {
+		buf.WriteString("id: " + id + "\n")
+	}
+	if event != "" {
+		buf.WriteString("event: " + event + "\n")
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		buf.WriteString("data: " + line + "\n")
+	}
+	buf.WriteString("\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func TestFormatEvent(t *testing.T) {
+	tests := []struct {
+		id     string
+		event  string
+		data   string
+		expect string
+	}{
+		{data: "hello", expect: "data: hello\n\n"},
+		{id: "1", event: "greeting", data: "hello", expect: "id: 1\nevent: greeting\ndata: hello\n\n"},
+		{data: "hello\nworld\n", expect: "data: hello\ndata: world\n\n"},
+		{data: "", expect: "data: \n\n"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expect, string(formatEvent(test.id, test.event, []byte(test.data))), test.data)
+	}
+}
+
+func TestWriteStream(t *testing.T) {
+	stream := []*interact.StreamMessage{
+		{Body: interact.NewBytesMessage([]byte("hello")), ID: "1", Event: "greeting"},
+		{Body: interact.NewBytesMessage([]byte("world")), Delay: 10},
+	}
+	tests := []struct {
+		name         string
+		contentType  string
+		cacheControl string
+		code         uint32
+		canceled     bool
+		// expectations
+		expectCode         int
+		expectBody         string
+		expectCacheControl string
+	}{
+		{
+			name:       "chunked",
+			expectCode: 200,
+			expectBody: "helloworld",
+		},
+		{
+			name:       "chunked with code",
+			code:       201,
+			expectCode: 201,
+			expectBody: "helloworld",
+		},
+		{
+			name:               "server-sent events",
+			contentType:        "text/event-stream; charset=utf-8",
+			expectCode:         200,
+			expectBody:         "id: 1\nevent: greeting\ndata: hello\n\ndata: world\n\n",
+			expectCacheControl: "no-cache",
+		},
+		{
+			name:               "server-sent events with cache control",
+			contentType:        "text/event-stream",
+			cacheControl:       "no-store",
+			expectCode:         200,
+			expectBody:         "id: 1\nevent: greeting\ndata: hello\n\ndata: world\n\n",
+			expectCacheControl: "no-store",
+		},
+		{
+			name:       "canceled",
+			canceled:   true,
+			expectCode: 200,
+			expectBody: "hello",
+		},
+	}
+	s := &MockServer{Logger: logger.NewDefault("test")}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		if test.contentType != "" {
+			w.Header().Set("Content-Type", test.contentType)
+		}
+		if test.cacheControl != "" {
+			w.Header().Set("Cache-Control", test.cacheControl)
+		}
+		ctx, cancel := context.WithCancel(context.TODO())
+		if test.canceled {
+			// the second message is not sent as the request is canceled during its delay
+			time.AfterFunc(time.Millisecond, cancel)
+		}
+		s.writeStream(ctx, w, &interact.Response{Code: test.code, Stream: stream})
+		cancel()
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+		assert.Equal(t, test.expectCacheControl, w.Header().Get("Cache-Control"), test.name)
+		assert.True(t, w.Flushed, test.name)
+	}
+}
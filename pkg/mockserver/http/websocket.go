@@ -0,0 +1,278 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/apimanager"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// closeTimeout is the duration to wait for the close frame of client after closing
+const closeTimeout = 5 * time.Second
+
+var errWebSocketClosed = errors.New("websocket is closed")
+
+var upgrader = websocket.Upgrader{
+	// mock server accepts connections from any origin
+	CheckOrigin: func(*http.Request) bool {
+		return true
+	},
+}
+
+// serveWebSocket is used to serve the WebSocket connection of request which matches a case with WebSocketResponse
+func (s *MockServer) serveWebSocket(w http.ResponseWriter, r *http.Request, request *interact.Request, mock *v1alpha1.MockAPI_Response_WebSocketResponse) {
+	handshake, err := s.apiManager.GenerateResponse(r.Context(), newSimpleResponse(mock.GetHeader(), ""), request)
+	if err != nil {
+		sendError(w, util.GetHTTPCodeFromError(err), err)
+		return
+	}
+	header := http.Header{}
+	for key, values := range handshake.Header {
+		for _, val := range values {
+			header.Add(key, val)
+		}
+	}
+	conn, err := upgrader.Upgrade(w, r, header)
+	if err != nil {
+		// the error response has been sent by upgrader
+		s.LogWarn(nil, "failed to upgrade websocket: %s", err)
+		return
+	}
+	session := &webSocketSession{
+		conn:       conn,
+		mock:       mock,
+		request:    request,
+		parseBody:  s.parseBody,
+		apiManager: s.apiManager,
+		Logger:     s.Logger.NewLogger("websocket"),
+	}
+	session.run(r.Context())
+}
+
+// webSocketSession is used to play the WebSocketResponse on a connection
+type webSocketSession struct {
+	conn    *websocket.Conn
+	mock    *v1alpha1.MockAPI_Response_WebSocketResponse
+	request *interact.Request
+	// closed is true once the close frame is sent
+	closed bool
+	// used to serialize writes and protect closed
+	lock sync.Mutex
+	// used to parse incoming messages
+	parseBody func(contentType string, body []byte) interact.Message
+
+	apiManager apimanager.Provider
+	logger.Logger
+}
+
+// run is used to serve the connection until it is closed
+func (w *webSocketSession) run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer w.conn.Close()
+	w.LogInfo(map[string]interface{}{
+		"path": w.request.Path,
+	}, "websocket connected")
+
+	go func() {
+		if err := w.sendFrames(ctx, w.mock.GetOnConnect(), w.request); err != nil {
+			w.logSendError(ctx, err)
+			return
+		}
+		for _, periodic := range w.mock.GetPeriodic() {
+			go w.sendPeriodic(ctx, periodic)
+		}
+		if c := w.mock.GetClose(); c != nil {
+			w.close(ctx, c)
+		}
+	}()
+
+	var messages []interact.Message
+	for {
+		_, data, err := w.conn.ReadMessage()
+		if err != nil {
+			w.LogInfo(map[string]interface{}{
+				"path": w.request.Path,
+			}, "websocket disconnected: %s", err)
+			return
+		}
+		message := w.parseBody("", data)
+		messages = append(messages, message)
+		request := *w.request
+		request.Body = message
+		request.Messages = messages
+		request.Timestamp = time.Now()
+		reply, err := w.matchReply(ctx, &request)
+		if err != nil {
+			w.LogWarn(nil, "failed to match reply: %s", err)
+			continue
+		}
+		if reply == nil {
+			continue
+		}
+		if err := w.sendFrames(ctx, reply.GetFrames(), &request); err != nil {
+			w.logSendError(ctx, err)
+			continue
+		}
+		if c := reply.GetClose(); c != nil {
+			w.close(ctx, c)
+		}
+	}
+}
+
+// matchReply is used to return the first reply whose condition matches the incoming message
+func (w *webSocketSession) matchReply(ctx context.Context, request *interact.Request) (*v1alpha1.MockAPI_Response_WebSocketResponse_Reply, error) {
+	for _, reply := range w.mock.GetReplies() {
+		matched, err := w.apiManager.MatchCondition(ctx, request, reply.GetCondition())
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return reply, nil
+		}
+	}
+	return nil, nil
+}
+
+// sendPeriodic is used to send the frame at the interval until the count is reached
+func (w *webSocketSession) sendPeriodic(ctx context.Context, periodic *v1alpha1.MockAPI_Response_WebSocketResponse_Periodic) {
+	interval := periodic.GetInterval().AsDuration()
+	if interval <= 0 || periodic.GetFrame() == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := uint32(0); periodic.GetCount() == 0 || i < periodic.GetCount(); i++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		frames := []*v1alpha1.MockAPI_Response_WebSocketResponse_Frame{periodic.GetFrame()}
+		if err := w.sendFrames(ctx, frames, w.request); err != nil {
+			w.logSendError(ctx, err)
+			return
+		}
+	}
+}
+
+// sendFrames is used to render and send frames in order
+func (w *webSocketSession) sendFrames(ctx context.Context, frames []*v1alpha1.MockAPI_Response_WebSocketResponse_Frame, request *interact.Request) error {
+	for _, frame := range frames {
+		if err := wait(ctx, frame.GetDelay().AsDuration()); err != nil {
+			return err
+		}
+		response, err := w.apiManager.GenerateResponse(ctx, newSimpleResponse(nil, frame.GetBody()), request)
+		if err != nil {
+			return err
+		}
+		messageType, data := websocket.TextMessage, response.Body.Bytes()
+		switch frame.GetType() {
+		case "", "text":
+		case "binary":
+			messageType = websocket.BinaryMessage
+			data, err = base64.StdEncoding.DecodeString(string(data))
+			if err != nil {
+				return fmt.Errorf("failed to decode binary frame: %s", err)
+			}
+		default:
+			return fmt.Errorf("unknown frame type: %s", frame.GetType())
+		}
+		if err := w.write(messageType, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write is used to write a message, it is safe for concurrent use
+func (w *webSocketSession) write(messageType int, data []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed {
+		return errWebSocketClosed
+	}
+	return w.conn.WriteMessage(messageType, data)
+}
+
+// close is used to send the close frame after the delay,
+// the connection is released once the client replies or closeTimeout is reached
+func (w *webSocketSession) close(ctx context.Context, c *v1alpha1.MockAPI_Response_WebSocketResponse_Close) {
+	if err := wait(ctx, c.GetDelay().AsDuration()); err != nil {
+		return
+	}
+	code := int(c.GetCode())
+	if code == 0 {
+		code = websocket.CloseNormalClosure
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed {
+		return
+	}
+	w.closed = true
+	deadline := time.Now().Add(closeTimeout)
+	if err := w.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, c.GetReason()), deadline); err != nil {
+		w.LogWarn(nil, "failed to send close frame: %s", err)
+	}
+	_ = w.conn.SetReadDeadline(deadline)
+}
+
+func (w *webSocketSession) logSendError(ctx context.Context, err error) {
+	if ctx.Err() != nil || err == errWebSocketClosed {
+		return
+	}
+	w.LogWarn(nil, "failed to send frames: %s", err)
+}
+
+// wait is used to sleep for the duration unless ctx is done
+func wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newSimpleResponse is used to create MockAPI_Response to render header and body by mock plugins
+func newSimpleResponse(header map[string]string, body string) *v1alpha1.MockAPI_Response {
+	return &v1alpha1.MockAPI_Response{
+		Response: &v1alpha1.MockAPI_Response_Simple{
+			Simple: &v1alpha1.MockAPI_Response_SimpleResponse{
+				Header: header,
+				Body:   body,
+			},
+		},
+	}
+}
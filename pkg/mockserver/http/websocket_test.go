@@ -0,0 +1,140 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/apimanager"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// fakeAPIManager responds with the simple body of the case and counts the matches of requests,
+// script conditions match the messages equal to their contents
+type fakeAPIManager struct {
+	apimanager.Provider
+	mockCase  *v1alpha1.MockAPI_Case
+	matches   int
+	protocols []interact.Protocol
+	requests  []*interact.Request
+}
+
+func (m *fakeAPIManager) MatchCase(ctx context.Context, request *interact.Request) (*v1alpha1.MockAPI_Case, error) {
+	m.matches++
+	m.protocols = append(m.protocols, request.Protocol)
+	m.requests = append(m.requests, request)
+	return m.mockCase, nil
+}
+
+func (m *fakeAPIManager) MockResponse(ctx context.Context, request *interact.Request) (*interact.Response, error) {
+	mockCase, err := m.MatchCase(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return m.GenerateResponse(ctx, mockCase.GetResponse(), request)
+}
+
+func (m *fakeAPIManager) MatchCondition(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (bool, error) {
+	return condition.GetScript() == nil || string(request.Body.Bytes()) == condition.GetScript().GetContent(), nil
+}
+
+func (m *fakeAPIManager) GenerateResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request) (*interact.Response, error) {
+	response := interact.NewDefaultResponse(request)
+	response.Body = interact.NewBytesMessage([]byte(mock.GetSimple().GetBody()))
+	return response, nil
+}
+
+func TestWebSocket(t *testing.T) {
+	frame := func(body string) []*v1alpha1.MockAPI_Response_WebSocketResponse_Frame {
+		return []*v1alpha1.MockAPI_Response_WebSocketResponse_Frame{{Body: body}}
+	}
+	websocketCase := &v1alpha1.MockAPI_Case{
+		Response: &v1alpha1.MockAPI_Response{
+			Response: &v1alpha1.MockAPI_Response_Websocket{
+				Websocket: &v1alpha1.MockAPI_Response_WebSocketResponse{
+					OnConnect: frame("welcome"),
+					Replies: []*v1alpha1.MockAPI_Response_WebSocketResponse_Reply{
+						{
+							Condition: &v1alpha1.MockAPI_Condition{
+								Condition: &v1alpha1.MockAPI_Condition_Script{
+									Script: &v1alpha1.MockAPI_Condition_ScriptCondition{Content: "ping"},
+								},
+							},
+							Frames: frame("pong"),
+						},
+						{Frames: frame("unknown")},
+					},
+				},
+			},
+		},
+	}
+	simpleCase := &v1alpha1.MockAPI_Case{
+		Response: &v1alpha1.MockAPI_Response{
+			Response: &v1alpha1.MockAPI_Response_Simple{
+				Simple: &v1alpha1.MockAPI_Response_SimpleResponse{Body: "plain"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mockCase *v1alpha1.MockAPI_Case
+		upgraded bool
+	}{
+		{name: "websocket response", mockCase: websocketCase, upgraded: true},
+		{name: "simple response", mockCase: simpleCase, upgraded: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiManager := &fakeAPIManager{mockCase: test.mockCase}
+			server := httptest.NewServer(&MockServer{
+				cfg:        NewConfig(),
+				apiManager: apiManager,
+				Logger:     logger.NewDefault("test"),
+			})
+			defer server.Close()
+
+			conn, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+			assert.Equal(t, test.upgraded, err == nil)
+			if !test.upgraded {
+				body, _ := ioutil.ReadAll(resp.Body)
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				assert.Equal(t, "plain", string(body))
+			} else {
+				defer conn.Close()
+				for _, expected := range [][2]string{{"", "welcome"}, {"ping", "pong"}, {"hello", "unknown"}} {
+					if expected[0] != "" {
+						assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(expected[0])))
+					}
+					_, data, err := conn.ReadMessage()
+					assert.Nil(t, err)
+					assert.Equal(t, expected[1], string(data))
+				}
+			}
+			assert.Equal(t, 1, apiManager.matches)
+			assert.Equal(t, []interact.Protocol{interact.ProtocolWebSocket}, apiManager.protocols)
+		})
+	}
+}
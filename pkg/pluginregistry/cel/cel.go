@@ -0,0 +1,329 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/jhump/protoreflect/desc"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// Lang is the language of ScriptCondition and ScriptResponse handled by this plugin
+const Lang = "cel"
+
+// matchTimeout is the time limit of evaluating a condition
+const matchTimeout = time.Second
+
+var (
+	_ pluginregistry.MockPlugin             = &Plugin{}
+	_ pluginregistry.MatchPlugin            = &Plugin{}
+	_ pluginregistry.ConditionValidator     = &Plugin{}
+	_ pluginregistry.ResponseValidator      = &Plugin{}
+	_ pluginregistry.ScriptLanguageProvider = &Plugin{}
+)
+
+// Plugin implements conditions and responses by Common Expression Language (CEL)
+type Plugin struct {
+	cfg *Config
+	// optional, the body of gRPC requests is typed by the input message of method if it is set
+	protoManager protomanager.Provider
+	programs     *programCache
+
+	registerer prometheus.Registerer
+	logger.Logger
+}
+
+// Config defines the config structure
+type Config struct {
+	Enable bool
+	// CostLimit is the max cost of evaluating an expression, which is indicative of CPU usage
+	CostLimit uint64
+}
+
+// NewConfig is used to init config with default values
+func NewConfig() *Config {
+	return &Config{
+		Enable:    true,
+		CostLimit: 1000000,
+	}
+}
+
+// IsEnabled is used to return whether the current component is enabled
+// This attribute is required in pluggable components
+func (c *Config) IsEnabled() bool {
+	return c.Enable
+}
+
+// RegisterFlagsWithPrefix is used to register flags
+func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
+	f.BoolVar(&c.Enable, prefix+"cel.enable", c.Enable, "define whether the component is enabled")
+	f.Uint64Var(&c.CostLimit, prefix+"cel.costLimit", c.CostLimit, "max cost of evaluating an expression")
+}
+
+// Validate is used to validate config and returns error on failure
+func (c *Config) Validate() error {
+	if c.CostLimit == 0 {
+		return errors.New("costLimit of cel should be positive")
+	}
+	return nil
+}
+
+func init() {
+	pluginregistry.Register("cel", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+		plugin, err := New(cfg, logger, registerer)
+		if err != nil {
+			return nil, err
+		}
+		if deps.ProtoManager != nil {
+			plugin.SetProtoManager(deps.ProtoManager)
+		}
+		return plugin, nil
+	})
+}
+
+// New is used to init service
+func New(cfg *Config, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+	service := &Plugin{
+		cfg:        cfg,
+		programs:   newProgramCache(cfg.CostLimit),
+		registerer: registerer,
+		Logger:     logger.NewLogger("celPlugin"),
+	}
+	return service, nil
+}
+
+// SetProtoManager is used to set the proto manager to type the body of gRPC requests,
+// it must be called before Start
+func (s *Plugin) SetProtoManager(protoManager protomanager.Provider) {
+	s.protoManager = protoManager
+}
+
+// Name is used to return the plugin name
+func (s *Plugin) Name() string {
+	return "cel"
+}
+
+// ScriptLanguages is used to return the languages handled by the plugin
+func (s *Plugin) ScriptLanguages() []string {
+	return []string{Lang}
+}
+
+// ValidateCondition is used to parse the expression of ScriptCondition and check whether it returns a bool
+func (s *Plugin) ValidateCondition(condition *v1alpha1.MockAPI_Condition) error {
+	script := condition.GetScript()
+	if script == nil || script.GetLang() != Lang {
+		return nil
+	}
+	program, err := s.compile(script.GetContent())
+	if err != nil || program == nil {
+		return err
+	}
+	return checkConditionType(program.outputType)
+}
+
+// ValidateResponse is used to parse the expression of ScriptResponse and check whether it returns a map
+func (s *Plugin) ValidateResponse(response *v1alpha1.MockAPI_Response) error {
+	script := response.GetScript()
+	if script == nil || script.GetLang() != Lang {
+		return nil
+	}
+	program, err := s.compile(script.GetContent())
+	if err != nil || program == nil {
+		return err
+	}
+	return checkResponseType(program.outputType)
+}
+
+// compile is used to compile the expression with the dynamic body,
+// it returns nil without error if the expression can only be compiled with the typed body of gRPC requests
+func (s *Plugin) compile(content string) (*program, error) {
+	expr, err := parse(content)
+	if err != nil {
+		return nil, err
+	}
+	program, err := s.programs.get(content, nil)
+	if err != nil {
+		// the expression may refer to the messages and enums of proto files which are only declared for gRPC requests,
+		// so it is only compiled with the typed body when gRPC requests are matched
+		if s.refersToProto(expr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return program, nil
+}
+
+// refersToProto is used to determine whether the expression refers to the names declared in the loaded proto files
+func (s *Plugin) refersToProto(expr *exprpb.Expr) bool {
+	if s.protoManager == nil {
+		return false
+	}
+	names := map[string]bool{}
+	undeclaredNames(expr, nil, names)
+	if len(names) == 0 {
+		return false
+	}
+	for _, file := range s.protoManager.ListFiles() {
+		fd := file.Descriptor
+		if names[strings.Split(fd.GetPackage(), ".")[0]] {
+			return true
+		}
+		for _, message := range fd.GetMessageTypes() {
+			if names[message.GetName()] {
+				return true
+			}
+		}
+		for _, enum := range fd.GetEnumTypes() {
+			if names[enum.GetName()] {
+				return true
+			}
+			for _, value := range enum.GetValues() {
+				if names[value.GetName()] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Match is used to determine whether interact.Request satisfies the matching condition of MockAPI_Condition
+func (s *Plugin) Match(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (match bool, err error) {
+	script := condition.GetScript()
+	if script == nil || script.GetLang() != Lang {
+		return false, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, matchTimeout)
+	defer cancel()
+	val, err := s.eval(ctx, request, script.GetContent())
+	if err != nil {
+		return false, err
+	}
+	matched, ok := val.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("result of condition is not a bool: %s", val.Type().TypeName())
+	}
+	return matched, nil
+}
+
+// MockResponse is used to generate interact.Response according to the given MockAPI_Response and interact.Request
+// The expression should return a map like the javascript, such as {"code": 0, "header": {...}, "body": {...}}
+func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request, response *interact.Response) (abort bool, err error) {
+	script := mock.GetScript()
+	if script == nil || script.GetLang() != Lang {
+		return false, nil
+	}
+
+	// get timeout
+	timeout := time.Second
+	if t := script.GetTimeout(); t != nil {
+		milliseconds := t.AsDuration().Milliseconds()
+		if milliseconds > 0 && milliseconds < 3000 {
+			timeout = t.AsDuration()
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	val, err := s.eval(ctx, request, script.GetContent())
+	if err != nil {
+		return true, err
+	}
+	if _, ok := val.(traits.Mapper); !ok {
+		return true, fmt.Errorf("result of response is not a map: %s", val.Type().TypeName())
+	}
+	native, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return true, err
+	}
+	responseRaw, err := protojson.Marshal(native.(*structpb.Value))
+	if err != nil {
+		return true, err
+	}
+	if err := jsoniter.Unmarshal(responseRaw, &response); err != nil {
+		return true, err
+	}
+	return false, nil
+}
+
+// eval is used to compile the expression with the type of request body and evaluate it
+func (s *Plugin) eval(ctx context.Context, request *interact.Request, content string) (ref.Val, error) {
+	input := s.getInputType(request)
+	program, err := s.programs.get(content, input)
+	if err != nil {
+		return nil, err
+	}
+	activation, err := newActivation(request, program.body)
+	if err != nil {
+		return nil, err
+	}
+	val, _, err := program.ContextEval(ctx, activation)
+	if err != nil {
+		return nil, err
+	}
+	if types.IsError(val) {
+		return nil, errors.New(fmt.Sprint(val))
+	}
+	return val, nil
+}
+
+// getInputType returns the input message of gRPC method, or nil if the request is not a gRPC request
+func (s *Plugin) getInputType(request *interact.Request) *desc.MessageDescriptor {
+	if s.protoManager == nil || !request.Protocol.IsGRPC() {
+		return nil
+	}
+	method, ok := s.protoManager.GetMethod(request.Path)
+	if !ok {
+		return nil
+	}
+	return method.GetInputType()
+}
+
+func checkConditionType(outputType *celgo.Type) error {
+	if outputType == nil || outputType.IsExactType(celgo.BoolType) || outputType.IsExactType(celgo.DynType) {
+		return nil
+	}
+	return fmt.Errorf("condition should return a bool, got: %s", outputType)
+}
+
+func checkResponseType(outputType *celgo.Type) error {
+	if outputType == nil || outputType.Kind() == types.MapKind || outputType.IsExactType(celgo.DynType) {
+		return nil
+	}
+	return fmt.Errorf("response should return a map, got: %s", outputType)
+}
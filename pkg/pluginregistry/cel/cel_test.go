@@ -0,0 +1,166 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// fakeProtoManager provides the proto file of powermock apis
+type fakeProtoManager struct {
+	protomanager.Provider
+	files []*protomanager.FileInfo
+}
+
+func (m *fakeProtoManager) ListFiles() []*protomanager.FileInfo {
+	return m.files
+}
+
+func newTestPlugin(t *testing.T, costLimit uint64) *Plugin {
+	cfg := NewConfig()
+	cfg.CostLimit = costLimit
+	plugin, err := New(cfg, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	fd, err := desc.LoadFileDescriptor("apis.proto")
+	assert.Nil(t, err)
+	plugin.SetProtoManager(&fakeProtoManager{files: []*protomanager.FileInfo{{Descriptor: fd}}})
+	return plugin
+}
+
+func newCondition(content string) *v1alpha1.MockAPI_Condition {
+	return &v1alpha1.MockAPI_Condition{
+		Condition: &v1alpha1.MockAPI_Condition_Script{
+			Script: &v1alpha1.MockAPI_Condition_ScriptCondition{Lang: Lang, Content: content},
+		},
+	}
+}
+
+func TestValidateCondition(t *testing.T) {
+	plugin := newTestPlugin(t, NewConfig().CostLimit)
+	tests := []struct {
+		content string
+		wantErr bool
+	}{
+		{content: `header["uid"] == "1" && body.name.startsWith("x")`, wantErr: false},
+		{content: `messages.exists(m, m.id > 1)`, wantErr: false},
+		{content: `body == powermock.apis.v1alpha1.MockAPI{uniqueKey: "x"}`, wantErr: false},
+		{content: `body == MockAPI{uniqueKey: "x"}`, wantErr: false},
+		{content: `header["uid"] ==`, wantErr: true},
+		{content: `bdy.name == "x"`, wantErr: true},
+		{content: `messages.exists(m, n.id > 1)`, wantErr: true},
+		{content: `body == unknown.Message{name: "x"}`, wantErr: true},
+		{content: `header["uid"]`, wantErr: true},
+	}
+	for _, test := range tests {
+		err := plugin.ValidateCondition(newCondition(test.content))
+		assert.Equal(t, test.wantErr, err != nil, "%s: %v", test.content, err)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	request := &interact.Request{
+		Protocol: interact.ProtocolHTTP,
+		Method:   "POST",
+		Header:   interact.Values{"uid": {"1", "2"}},
+		Query:    map[string][]string{"page": {"3"}},
+		Body:     interact.NewBytesMessage([]byte(`{"name": "xyz", "ids": [1, 2, 3, 4, 5, 6, 7, 8, 9, 10]}`)),
+	}
+	tests := []struct {
+		content   string
+		costLimit uint64
+		want      bool
+		wantErr   bool
+	}{
+		{content: `header["uid"] == "1" && headers["uid"].size() == 2 && query["page"] == "3"`, want: true},
+		{content: `body.name.startsWith("x") && method == "POST"`, want: true},
+		{content: `body.ids.all(id, id > 0)`, want: true},
+		{content: `body.ids.all(id, id > 0)`, costLimit: 10, wantErr: true},
+		{content: `body.name`, wantErr: true},
+	}
+	for _, test := range tests {
+		costLimit := test.costLimit
+		if costLimit == 0 {
+			costLimit = NewConfig().CostLimit
+		}
+		plugin := newTestPlugin(t, costLimit)
+		matched, err := plugin.Match(context.TODO(), request, newCondition(test.content))
+		assert.Equal(t, test.wantErr, err != nil, "%s: %v", test.content, err)
+		assert.Equal(t, test.want, matched, test.content)
+	}
+}
+
+func TestMockResponse(t *testing.T) {
+	plugin := newTestPlugin(t, NewConfig().CostLimit)
+	request := &interact.Request{
+		Header: interact.Values{"uid": {"1"}},
+		Body:   interact.NewBytesMessage([]byte(`{"name": "xyz"}`)),
+	}
+	tests := []struct {
+		content  string
+		wantBody string
+		wantErr  bool
+	}{
+		{content: `{"code": 200, "header": {"x-uid": [header["uid"]]}, "body": {"name": body.name + "-cel"}}`, wantBody: `{"name":"xyz-cel"}`},
+		{content: `"not a map"`, wantErr: true},
+		{content: `{"body": unknown}`, wantErr: true},
+	}
+	for _, test := range tests {
+		response := interact.NewDefaultResponse(request)
+		abort, err := plugin.MockResponse(context.TODO(), &v1alpha1.MockAPI_Response{
+			Response: &v1alpha1.MockAPI_Response_Script{
+				Script: &v1alpha1.MockAPI_Response_ScriptResponse{Lang: Lang, Content: test.content},
+			},
+		}, request, response)
+		assert.Equal(t, test.wantErr, err != nil, "%s: %v", test.content, err)
+		assert.Equal(t, test.wantErr, abort, test.content)
+		if !test.wantErr {
+			assert.Equal(t, uint32(200), response.Code)
+			assert.Equal(t, "1", response.Header.Get("x-uid"))
+			assert.JSONEq(t, test.wantBody, string(response.Body.Bytes()))
+		}
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	plugin := newTestPlugin(t, NewConfig().CostLimit)
+	tests := []struct {
+		content string
+		wantErr bool
+	}{
+		{content: `{"code": 200, "body": {"name": body.name}}`, wantErr: false},
+		{content: `request`, wantErr: false},
+		{content: `{"body": MockAPI{uniqueKey: "x"}}`, wantErr: false},
+		{content: `"not a map"`, wantErr: true},
+		{content: `{"body": bdy.name}`, wantErr: true},
+	}
+	for _, test := range tests {
+		err := plugin.ValidateResponse(&v1alpha1.MockAPI_Response{
+			Response: &v1alpha1.MockAPI_Response_Script{
+				Script: &v1alpha1.MockAPI_Response_ScriptResponse{Lang: Lang, Content: test.content},
+			},
+		})
+		assert.Equal(t, test.wantErr, err != nil, "%s: %v", test.content, err)
+	}
+}
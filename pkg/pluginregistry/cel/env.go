@@ -0,0 +1,348 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cel
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"github.com/jhump/protoreflect/desc"
+	jsoniter "github.com/json-iterator/go"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+// maxCacheSize is the max number of cached programs and environments,
+// the cache is cleared when it is exceeded, such as MockAPIs are changed or proto files are reloaded many times
+const maxCacheSize = 1024
+
+// program is the compiled expression
+type program struct {
+	celgo.Program
+	outputType *celgo.Type
+	// body is the message type of request body, it is nil if the body is dynamic
+	body protoreflect.MessageDescriptor
+}
+
+// environment is the CEL environment of the given message type of request body
+type environment struct {
+	env  *celgo.Env
+	body protoreflect.MessageDescriptor
+}
+
+// programKey identifies the compiled expression, expressions are compiled once
+// for each content of MockAPI and type of request body
+type programKey struct {
+	content string
+	input   *desc.MessageDescriptor
+}
+
+// programCache caches the compiled programs and the environments of message types
+type programCache struct {
+	lock         sync.Mutex
+	costLimit    uint64
+	programs     map[programKey]*program
+	environments map[*desc.MessageDescriptor]*environment
+}
+
+// newProgramCache is used to create the cache of programs, whose evaluation is aborted if costLimit is exceeded
+func newProgramCache(costLimit uint64) *programCache {
+	return &programCache{
+		costLimit:    costLimit,
+		programs:     map[programKey]*program{},
+		environments: map[*desc.MessageDescriptor]*environment{},
+	}
+}
+
+// get is used to get the compiled program of content, the body is dynamic if input is nil
+func (c *programCache) get(content string, input *desc.MessageDescriptor) (*program, error) {
+	key := programKey{content: content, input: input}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if p, ok := c.programs[key]; ok {
+		return p, nil
+	}
+	env, err := c.getEnvironment(input)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.env.Compile(content)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	prg, err := env.env.Program(ast, celgo.InterruptCheckFrequency(100), celgo.CostLimit(c.costLimit))
+	if err != nil {
+		return nil, err
+	}
+	if len(c.programs) >= maxCacheSize {
+		c.programs = map[programKey]*program{}
+	}
+	p := &program{Program: prg, outputType: ast.OutputType(), body: env.body}
+	c.programs[key] = p
+	return p, nil
+}
+
+func (c *programCache) getEnvironment(input *desc.MessageDescriptor) (*environment, error) {
+	if env, ok := c.environments[input]; ok {
+		return env, nil
+	}
+	env, err := newEnvironment(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.environments) >= maxCacheSize {
+		c.environments = map[*desc.MessageDescriptor]*environment{}
+	}
+	c.environments[input] = env
+	return env, nil
+}
+
+// parse is used to check the syntax of expression and return the parsed expression
+func parse(content string) (*exprpb.Expr, error) {
+	env, err := celgo.NewEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Parse(content)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	parsed, err := celgo.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.GetExpr(), nil
+}
+
+// dynamicVariables are the variables with the dynamic body
+var dynamicVariables = variables(celgo.DynType)
+
+// variables returns the types of variables of request, body and messages are typed by bodyType
+func variables(bodyType *celgo.Type) map[string]*celgo.Type {
+	stringMap := celgo.MapType(celgo.StringType, celgo.StringType)
+	listMap := celgo.MapType(celgo.StringType, celgo.ListType(celgo.StringType))
+	return map[string]*celgo.Type{
+		"request":    celgo.DynType,
+		"protocol":   celgo.StringType,
+		"method":     celgo.StringType,
+		"host":       celgo.StringType,
+		"path":       celgo.StringType,
+		"proto":      celgo.StringType,
+		"ip":         celgo.StringType,
+		"remoteAddr": celgo.StringType,
+		"timestamp":  celgo.TimestampType,
+		"header":     stringMap,
+		"headers":    listMap,
+		"query":      stringMap,
+		"queries":    listMap,
+		"params":     stringMap,
+		"cookies":    stringMap,
+		"body":       bodyType,
+		"messages":   celgo.ListType(bodyType),
+	}
+}
+
+// newEnvironment is used to declare the variables of request,
+// body and messages are typed by input if it is not nil
+func newEnvironment(input *desc.MessageDescriptor) (*environment, error) {
+	opts := []celgo.EnvOption{
+		ext.Strings(),
+		ext.Encoders(),
+		ext.Math(),
+		ext.Lists(),
+	}
+	result := &environment{}
+	bodyType := celgo.DynType
+	if input != nil {
+		files, err := newFiles(input.GetFile())
+		if err != nil {
+			return nil, err
+		}
+		d, err := files.FindDescriptorByName(protoreflect.FullName(input.GetFullyQualifiedName()))
+		if err != nil {
+			return nil, err
+		}
+		body, ok := d.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a message", input.GetFullyQualifiedName())
+		}
+		result.body = body
+		bodyType = celgo.ObjectType(string(body.FullName()))
+		opts = append(opts, celgo.TypeDescs(files), celgo.Container(string(body.ParentFile().Package())))
+	}
+	for name, t := range variables(bodyType) {
+		opts = append(opts, celgo.Variable(name, t))
+	}
+	env, err := celgo.NewEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+	result.env = env
+	return result, nil
+}
+
+// undeclaredNames is used to collect the names referred by the expression which are not variables,
+// such as messages and enums of proto files, only the first segment of qualified names is collected
+func undeclaredNames(expr *exprpb.Expr, locals map[string]bool, names map[string]bool) {
+	switch kind := expr.GetExprKind().(type) {
+	case *exprpb.Expr_IdentExpr:
+		name := kind.IdentExpr.GetName()
+		if _, ok := dynamicVariables[name]; !ok && !locals[name] {
+			names[name] = true
+		}
+	case *exprpb.Expr_SelectExpr:
+		undeclaredNames(kind.SelectExpr.GetOperand(), locals, names)
+	case *exprpb.Expr_CallExpr:
+		if target := kind.CallExpr.GetTarget(); target != nil {
+			undeclaredNames(target, locals, names)
+		}
+		for _, arg := range kind.CallExpr.GetArgs() {
+			undeclaredNames(arg, locals, names)
+		}
+	case *exprpb.Expr_ListExpr:
+		for _, element := range kind.ListExpr.GetElements() {
+			undeclaredNames(element, locals, names)
+		}
+	case *exprpb.Expr_StructExpr:
+		if messageName := strings.TrimPrefix(kind.StructExpr.GetMessageName(), "."); messageName != "" {
+			names[strings.Split(messageName, ".")[0]] = true
+		}
+		for _, entry := range kind.StructExpr.GetEntries() {
+			if key := entry.GetMapKey(); key != nil {
+				undeclaredNames(key, locals, names)
+			}
+			undeclaredNames(entry.GetValue(), locals, names)
+		}
+	case *exprpb.Expr_ComprehensionExpr:
+		comprehension := kind.ComprehensionExpr
+		undeclaredNames(comprehension.GetIterRange(), locals, names)
+		undeclaredNames(comprehension.GetAccuInit(), locals, names)
+		scoped := map[string]bool{comprehension.GetIterVar(): true, comprehension.GetAccuVar(): true}
+		for name := range locals {
+			scoped[name] = true
+		}
+		undeclaredNames(comprehension.GetLoopCondition(), scoped, names)
+		undeclaredNames(comprehension.GetLoopStep(), scoped, names)
+		undeclaredNames(comprehension.GetResult(), scoped, names)
+	}
+}
+
+// newFiles is used to convert the file and its dependencies to protoregistry.Files,
+// the descriptors linked into the binary are used for well-known types so that they are handled natively
+func newFiles(file *desc.FileDescriptor) (*protoregistry.Files, error) {
+	files := new(protoregistry.Files)
+	for _, fdp := range desc.ToFileDescriptorSet(file).GetFile() {
+		fd, err := protoregistry.GlobalFiles.FindFileByPath(fdp.GetName())
+		if err != nil {
+			fd, err = protodesc.NewFile(fdp, files)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := files.RegisterFile(fd); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// newActivation is used to convert the request to variables,
+// the body is decoded to the message of body type if it is not nil
+func newActivation(request *interact.Request, body protoreflect.MessageDescriptor) (map[string]interface{}, error) {
+	generic, err := toGeneric(request)
+	if err != nil {
+		return nil, err
+	}
+	vars := map[string]interface{}{
+		"request":    generic,
+		"protocol":   string(request.Protocol),
+		"method":     request.Method,
+		"host":       request.Host,
+		"path":       request.Path,
+		"proto":      request.Proto,
+		"ip":         request.IP,
+		"remoteAddr": request.RemoteAddr,
+		"timestamp":  request.Timestamp,
+		"header":     request.Header.First(),
+		"headers":    map[string][]string(request.Header),
+		"query":      interact.Values(request.Query).First(),
+		"queries":    request.Query,
+		"params":     request.Params,
+		"cookies":    request.Cookies,
+	}
+	if vars["body"], err = decodeMessage(request.Body, body); err != nil {
+		return nil, err
+	}
+	messages := make([]interface{}, 0, len(request.Messages))
+	for _, message := range request.Messages {
+		decoded, err := decodeMessage(message, body)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, decoded)
+	}
+	vars["messages"] = messages
+	return vars, nil
+}
+
+// decodeMessage is used to decode message to the message of body type,
+// or generic values if body type is nil, the raw data is used as a string if it is not valid JSON
+func decodeMessage(message interact.Message, body protoreflect.MessageDescriptor) (interface{}, error) {
+	if body != nil {
+		decoded := dynamicpb.NewMessage(body)
+		if message != nil && len(message.Bytes()) > 0 {
+			err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(message.Bytes(), decoded)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return decoded, nil
+	}
+	if message == nil {
+		return nil, nil
+	}
+	raw, err := message.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return string(message.Bytes()), nil
+	}
+	return v, nil
+}
+
+// toGeneric is used to convert the request to generic values like the request of javascript
+func toGeneric(request *interact.Request) (interface{}, error) {
+	raw, err := jsoniter.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,327 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+var _ pluginregistry.MockPlugin = &Plugin{}
+var _ pluginregistry.MatchPlugin = &Plugin{}
+var _ pluginregistry.ConditionValidator = &Plugin{}
+var _ pluginregistry.ResponseValidator = &Plugin{}
+
+// Plugin implements conditions, responses and storage by external plugin processes over gRPC
+type Plugin struct {
+	cfg       *Config
+	processes map[string]*process
+	storage   *storage
+	socketDir string
+
+	registerer prometheus.Registerer
+	logger.Logger
+}
+
+// Config defines the config structure
+type Config struct {
+	Enable bool
+	// Plugins are declared in the config file only
+	Plugins []*PluginConfig
+}
+
+// PluginConfig defines the config of an external plugin
+type PluginConfig struct {
+	// Name is referred by the plugin field of external conditions and responses
+	Name string
+	// Command is the executable of plugin, it is launched by powermock with Args, Env and Dir
+	Command string
+	Args    []string
+	Env     []string
+	Dir     string
+	// Address is the address of plugin which is started by others, such as 127.0.0.1:8000,
+	// it is only used if Command is empty
+	Address string
+	// Storage defines whether the plugin is used as the storage plugin
+	Storage bool
+	// Timeout is the default and max time limit of each call
+	Timeout time.Duration
+	// StartTimeout is the time limit of waiting for the plugin to be healthy after it is launched
+	StartTimeout time.Duration
+	// HealthCheckInterval is the interval of health checks, the plugin is restarted
+	// if it exits or fails maxHealthCheckFailures health checks in a row
+	HealthCheckInterval time.Duration
+}
+
+// NewConfig is used to init config with default values
+func NewConfig() *Config {
+	return &Config{
+		Enable: true,
+	}
+}
+
+// IsEnabled is used to return whether the current component is enabled
+// This attribute is required in pluggable components
+func (c *Config) IsEnabled() bool {
+	return c.Enable
+}
+
+// RegisterFlagsWithPrefix is used to register flags
+func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
+	f.BoolVar(&c.Enable, prefix+"external.enable", c.Enable, "define whether the component is enabled")
+}
+
+// Validate is used to validate config and returns error on failure
+func (c *Config) Validate() error {
+	names := map[string]bool{}
+	storage := ""
+	for _, plugin := range c.Plugins {
+		if plugin.Name == "" {
+			return errors.New("name of external plugin is required")
+		}
+		if names[plugin.Name] {
+			return fmt.Errorf("external plugin %s is declared more than once", plugin.Name)
+		}
+		names[plugin.Name] = true
+		if plugin.Command == "" && plugin.Address == "" {
+			return fmt.Errorf("command or address of external plugin %s is required", plugin.Name)
+		}
+		if plugin.Timeout < 0 || plugin.StartTimeout < 0 || plugin.HealthCheckInterval < 0 {
+			return fmt.Errorf("timeouts and interval of external plugin %s should not be negative", plugin.Name)
+		}
+		if plugin.Storage {
+			if storage != "" {
+				return fmt.Errorf("external plugins %s and %s can not be both used as the storage plugin", storage, plugin.Name)
+			}
+			storage = plugin.Name
+		}
+	}
+	return nil
+}
+
+func init() {
+	pluginregistry.Register("external", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+		// the plugin is unavailable if no external plugin is declared
+		if len(cfg.Plugins) == 0 {
+			return nil, nil
+		}
+		return New(cfg, logger, registerer)
+	})
+}
+
+// New is used to init service
+func New(cfg *Config, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+	service := &Plugin{
+		cfg:        cfg,
+		processes:  map[string]*process{},
+		registerer: registerer,
+		Logger:     logger.NewLogger("externalPlugin"),
+	}
+	for _, pluginCfg := range cfg.Plugins {
+		if pluginCfg.Command != "" && service.socketDir == "" {
+			dir, err := os.MkdirTemp("", "powermock-plugins-")
+			if err != nil {
+				return nil, err
+			}
+			service.socketDir = dir
+		}
+		p, err := newProcess(withDefaults(pluginCfg), service.socketDir, service.Logger)
+		if err != nil {
+			return nil, err
+		}
+		service.processes[pluginCfg.Name] = p
+		if pluginCfg.Storage {
+			service.storage = newStorage(p)
+		}
+	}
+	return service, nil
+}
+
+// withDefaults returns a copy of cfg with default values of unspecified durations
+func withDefaults(cfg *PluginConfig) *PluginConfig {
+	c := *cfg
+	if c.Timeout == 0 {
+		c.Timeout = 3 * time.Second
+	}
+	if c.StartTimeout == 0 {
+		c.StartTimeout = 10 * time.Second
+	}
+	if c.HealthCheckInterval == 0 {
+		c.HealthCheckInterval = 5 * time.Second
+	}
+	return &c
+}
+
+// Name is used to return the plugin name
+func (s *Plugin) Name() string {
+	return "external"
+}
+
+// StoragePlugin is used to return the storage plugin, it is nil if no plugin is used as the storage plugin
+func (s *Plugin) StoragePlugin() pluginregistry.StoragePlugin {
+	if s.storage == nil {
+		return nil
+	}
+	return s.storage
+}
+
+// Start is used to launch the plugins and wait for them to be healthy,
+// the plugins are health-checked and restarted in the background until ctx is done
+func (s *Plugin) Start(ctx context.Context, cancelFunc context.CancelFunc) error {
+	for _, p := range s.processes {
+		if err := p.start(ctx); err != nil {
+			return fmt.Errorf("failed to start external plugin %s: %s", p.cfg.Name, err)
+		}
+		capabilities := p.getCapabilities()
+		if p.cfg.Storage && !capabilities.GetStorage() {
+			return fmt.Errorf("external plugin %s does not implement storage", p.cfg.Name)
+		}
+		s.LogInfo(map[string]interface{}{
+			"plugin":  p.cfg.Name,
+			"match":   capabilities.GetMatch(),
+			"mock":    capabilities.GetMock(),
+			"storage": capabilities.GetStorage(),
+		}, "external plugin started")
+	}
+	if s.socketDir != "" {
+		go func() {
+			<-ctx.Done()
+			_ = os.RemoveAll(s.socketDir)
+		}()
+	}
+	return nil
+}
+
+// ValidateCondition is used to check whether the plugin of external condition is declared
+func (s *Plugin) ValidateCondition(condition *v1alpha1.MockAPI_Condition) error {
+	external := condition.GetExternal()
+	if external == nil {
+		return nil
+	}
+	if _, ok := s.processes[external.GetPlugin()]; !ok {
+		return fmt.Errorf("external plugin not found: %s", external.GetPlugin())
+	}
+	return nil
+}
+
+// ValidateResponse is used to check whether the plugin of external response is declared
+func (s *Plugin) ValidateResponse(response *v1alpha1.MockAPI_Response) error {
+	external := response.GetExternal()
+	if external == nil {
+		return nil
+	}
+	if _, ok := s.processes[external.GetPlugin()]; !ok {
+		return fmt.Errorf("external plugin not found: %s", external.GetPlugin())
+	}
+	return nil
+}
+
+// Match is used to determine whether interact.Request satisfies the matching condition of MockAPI_Condition
+func (s *Plugin) Match(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (match bool, err error) {
+	external := condition.GetExternal()
+	if external == nil {
+		return false, nil
+	}
+	p, err := s.getProcess(external.GetPlugin())
+	if err != nil {
+		return false, err
+	}
+	if !p.getCapabilities().GetMatch() {
+		return false, fmt.Errorf("external plugin %s does not implement match", p.cfg.Name)
+	}
+	requestRaw, err := jsoniter.Marshal(request)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+	resp, err := p.client.Match(ctx, &v1alpha1.PluginMatchRequest{
+		Request:   requestRaw,
+		Condition: condition,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetMatch(), nil
+}
+
+// MockResponse is used to generate interact.Response according to the given MockAPI_Response and interact.Request
+func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request, response *interact.Response) (abort bool, err error) {
+	external := mock.GetExternal()
+	if external == nil {
+		return false, nil
+	}
+	p, err := s.getProcess(external.GetPlugin())
+	if err != nil {
+		return true, err
+	}
+	if !p.getCapabilities().GetMock() {
+		return true, fmt.Errorf("external plugin %s does not implement mock", p.cfg.Name)
+	}
+	requestRaw, err := jsoniter.Marshal(request)
+	if err != nil {
+		return true, err
+	}
+	responseRaw, err := jsoniter.Marshal(response)
+	if err != nil {
+		return true, err
+	}
+
+	// get timeout
+	timeout := p.cfg.Timeout
+	if t := external.GetTimeout(); t != nil && t.AsDuration() > 0 && t.AsDuration() < timeout {
+		timeout = t.AsDuration()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	resp, err := p.client.MockResponse(ctx, &v1alpha1.PluginMockResponseRequest{
+		Request:  requestRaw,
+		Mock:     mock,
+		Response: responseRaw,
+	})
+	if err != nil {
+		return true, err
+	}
+	if len(resp.GetResponse()) > 0 {
+		if err := jsoniter.Unmarshal(resp.GetResponse(), &response); err != nil {
+			return true, err
+		}
+	}
+	return resp.GetAbort(), nil
+}
+
+func (s *Plugin) getProcess(name string) (*process, error) {
+	p, ok := s.processes[name]
+	if !ok {
+		return nil, fmt.Errorf("external plugin not found: %s", name)
+	}
+	if p.getCapabilities() == nil {
+		return nil, fmt.Errorf("external plugin %s is not started", name)
+	}
+	return p, nil
+}
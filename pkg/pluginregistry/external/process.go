@@ -0,0 +1,267 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+const (
+	// EnvAddress is the environment variable of the unix socket which the launched plugin should listen on
+	EnvAddress = "POWERMOCK_PLUGIN_ADDRESS"
+	// EnvName is the environment variable of the name of launched plugin
+	EnvName = "POWERMOCK_PLUGIN_NAME"
+
+	// maxHealthCheckFailures is the number of failed health checks in a row to restart the plugin
+	maxHealthCheckFailures = 3
+	// restartDelay is the delay before the exited plugin is restarted
+	restartDelay = time.Second
+)
+
+// process manages the connection of an external plugin, and the process of it if it is launched by powermock
+type process struct {
+	cfg          *PluginConfig
+	socket       string
+	conn         *grpc.ClientConn
+	client       v1alpha1.PluginClient
+	health       grpc_health_v1.HealthClient
+	capabilities *v1alpha1.PluginDescribeResponse
+	// used to protect capabilities, which is described again after the plugin is restarted
+	capabilitiesLock sync.RWMutex
+	// onRestarted are called after the plugin is restarted and described
+	onRestarted []func()
+
+	cmd    *exec.Cmd
+	exited chan error
+
+	logger.Logger
+}
+
+func newProcess(cfg *PluginConfig, socketDir string, log logger.Logger) (*process, error) {
+	p := &process{
+		cfg:    cfg,
+		Logger: log.NewLogger(cfg.Name),
+	}
+	target := cfg.Address
+	if cfg.Command != "" {
+		p.socket = filepath.Join(socketDir, cfg.Name+".sock")
+		target = "unix://" + p.socket
+	}
+	// the connection is established lazily and reconnected by gRPC after the plugin is restarted
+	conn, err := grpc.Dial(target, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	p.conn = conn
+	p.client = v1alpha1.NewPluginClient(conn)
+	p.health = grpc_health_v1.NewHealthClient(conn)
+	return p, nil
+}
+
+// start is used to launch the plugin, wait for it to be healthy and describe its capabilities
+func (p *process) start(ctx context.Context) error {
+	if err := p.launch(ctx); err != nil {
+		return err
+	}
+	if err := p.waitHealthy(ctx); err != nil {
+		return err
+	}
+	if err := p.describe(ctx); err != nil {
+		return err
+	}
+	go p.monitor(ctx)
+	go func() {
+		<-ctx.Done()
+		_ = p.conn.Close()
+	}()
+	return nil
+}
+
+// describe is used to update the capabilities of plugin
+func (p *process) describe(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+	capabilities, err := p.client.Describe(ctx, &v1alpha1.PluginDescribeRequest{})
+	if err != nil {
+		return err
+	}
+	p.capabilitiesLock.Lock()
+	p.capabilities = capabilities
+	p.capabilitiesLock.Unlock()
+	return nil
+}
+
+// getCapabilities returns the capabilities of plugin, it is nil if the plugin is not started
+func (p *process) getCapabilities() *v1alpha1.PluginDescribeResponse {
+	p.capabilitiesLock.RLock()
+	defer p.capabilitiesLock.RUnlock()
+	return p.capabilities
+}
+
+// launch is used to start the process of plugin, it does nothing if the plugin is not launched by powermock
+func (p *process) launch(ctx context.Context) error {
+	if p.cfg.Command == "" {
+		return nil
+	}
+	if err := os.Remove(p.socket); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, p.cfg.Command, p.cfg.Args...)
+	cmd.Dir = p.cfg.Dir
+	cmd.Env = append(os.Environ(), p.cfg.Env...)
+	cmd.Env = append(cmd.Env, EnvAddress+"="+p.socket, EnvName+"="+p.cfg.Name)
+	stdout, stderr := p.newLogWriter("stdout"), p.newLogWriter("stderr")
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		_ = stdout.Close()
+		_ = stderr.Close()
+		return err
+	}
+	p.LogInfo(map[string]interface{}{"pid": cmd.Process.Pid, "socket": p.socket}, "external plugin launched")
+	exited := make(chan error, 1)
+	go func() {
+		err := cmd.Wait()
+		_ = stdout.Close()
+		_ = stderr.Close()
+		exited <- err
+	}()
+	p.cmd = cmd
+	p.exited = exited
+	return nil
+}
+
+// waitHealthy is used to wait until the plugin is serving or StartTimeout is exceeded
+func (p *process) waitHealthy(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.StartTimeout)
+	defer cancel()
+	for {
+		err := p.check(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case err := <-p.exited:
+			p.cmd = nil
+			return fmt.Errorf("exited before it is healthy: %v", err)
+		case <-ctx.Done():
+			return fmt.Errorf("not healthy within %s: %s", p.cfg.StartTimeout, err)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// check is used to perform a health check
+func (p *process) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+	resp, err := p.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("status is %s", resp.GetStatus())
+	}
+	return nil
+}
+
+// monitor is used to health-check the plugin periodically,
+// the plugin is killed if it fails too many health checks and restarted after it exits
+func (p *process) monitor(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.HealthCheckInterval)
+	defer ticker.Stop()
+	failures := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-p.exited:
+			p.LogError(nil, "external plugin exited: %v", err)
+			p.restart(ctx)
+			failures = 0
+		case <-ticker.C:
+			err := p.check(ctx)
+			if err == nil {
+				failures = 0
+				continue
+			}
+			failures++
+			p.LogWarn(map[string]interface{}{"failures": failures}, "external plugin health check failed: %s", err)
+			if failures >= maxHealthCheckFailures && p.cmd != nil {
+				p.LogError(nil, "external plugin is unhealthy, start to kill it")
+				_ = p.cmd.Process.Kill()
+			}
+		}
+	}
+}
+
+// restart is used to launch the exited plugin again until it is healthy and described or ctx is done
+func (p *process) restart(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(restartDelay):
+		}
+		if err := p.launch(ctx); err != nil {
+			p.LogError(nil, "failed to restart external plugin: %s", err)
+			continue
+		}
+		err := p.waitHealthy(ctx)
+		if err == nil {
+			err = p.describe(ctx)
+		}
+		if err != nil {
+			p.LogError(nil, "failed to restart external plugin: %s", err)
+			if p.cmd != nil {
+				_ = p.cmd.Process.Kill()
+				<-p.exited
+			}
+			continue
+		}
+		p.LogInfo(nil, "external plugin restarted")
+		for _, fn := range p.onRestarted {
+			fn()
+		}
+		return
+	}
+}
+
+// newLogWriter returns a writer which logs each line written by the plugin until it is closed
+func (p *process) newLogWriter(stream string) *io.PipeWriter {
+	reader, writer := io.Pipe()
+	go func() {
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			p.LogInfo(map[string]interface{}{"stream": stream}, "%s", scanner.Text())
+		}
+		_ = reader.CloseWithError(scanner.Err())
+	}()
+	return writer
+}
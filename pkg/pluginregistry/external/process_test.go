@@ -0,0 +1,182 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+const (
+	// helperName is the name of plugin which is served by the test binary itself
+	helperName = "helper"
+	// envHelperDir is the directory where the helper plugin counts its launches
+	envHelperDir = "HELPER_DIR"
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv(EnvName) == helperName {
+		runHelperPlugin()
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// helperPlugin implements mock since it is restarted, and it is unhealthy in the first launch
+// if the file unhealthy exists in the helper dir
+type helperPlugin struct {
+	v1alpha1.UnimplementedPluginServer
+	launches int
+}
+
+func (p *helperPlugin) Describe(ctx context.Context, request *v1alpha1.PluginDescribeRequest) (*v1alpha1.PluginDescribeResponse, error) {
+	return &v1alpha1.PluginDescribeResponse{Match: true, Mock: p.launches > 1, Storage: true}, nil
+}
+
+func (p *helperPlugin) StorageWatch(request *v1alpha1.PluginStorageWatchRequest, stream v1alpha1.Plugin_StorageWatchServer) error {
+	<-stream.Context().Done()
+	return nil
+}
+
+func runHelperPlugin() {
+	dir := os.Getenv(envHelperDir)
+	counter := filepath.Join(dir, "launches")
+	data, _ := ioutil.ReadFile(counter)
+	launches, _ := strconv.Atoi(string(data))
+	launches++
+	if err := ioutil.WriteFile(counter, []byte(strconv.Itoa(launches)), 0644); err != nil {
+		panic(err)
+	}
+	listener, err := net.Listen("unix", os.Getenv(EnvAddress))
+	if err != nil {
+		panic(err)
+	}
+	healthServer := health.NewServer()
+	go func() {
+		for range time.Tick(10 * time.Millisecond) {
+			status := grpc_health_v1.HealthCheckResponse_SERVING
+			if _, err := os.Stat(filepath.Join(dir, "unhealthy")); err == nil && launches == 1 {
+				status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			}
+			healthServer.SetServingStatus("", status)
+		}
+	}()
+	server := grpc.NewServer()
+	v1alpha1.RegisterPluginServer(server, &helperPlugin{launches: launches})
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	_ = server.Serve(listener)
+}
+
+func newHelperProcess(t *testing.T, command string) (*process, string) {
+	dir := t.TempDir()
+	cfg := withDefaults(&PluginConfig{
+		Name:                helperName,
+		Command:             command,
+		Env:                 []string{envHelperDir + "=" + dir},
+		StartTimeout:        5 * time.Second,
+		HealthCheckInterval: 50 * time.Millisecond,
+	})
+	p, err := newProcess(cfg, dir, logger.NewDefault("test"))
+	assert.Nil(t, err)
+	return p, dir
+}
+
+func getLaunches(dir string) int {
+	data, _ := ioutil.ReadFile(filepath.Join(dir, "launches"))
+	launches, _ := strconv.Atoi(string(data))
+	return launches
+}
+
+func TestProcessLaunch(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		wantErr bool
+	}{
+		{name: "healthy", command: os.Args[0], wantErr: false},
+		{name: "not found", command: filepath.Join(t.TempDir(), "not-found"), wantErr: true},
+		{name: "exited", command: "true", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			p, dir := newHelperProcess(t, test.command)
+			err := p.start(ctx)
+			assert.Equal(t, test.wantErr, err != nil, "%v", err)
+			if test.wantErr {
+				assert.Nil(t, p.getCapabilities())
+				return
+			}
+			assert.Equal(t, 1, getLaunches(dir))
+			assert.True(t, p.getCapabilities().GetMatch())
+			assert.False(t, p.getCapabilities().GetMock())
+		})
+	}
+}
+
+func TestProcessRestart(t *testing.T) {
+	tests := []struct {
+		name string
+		// fail is used to make the launched plugin fail
+		fail func(p *process, dir string)
+	}{
+		{
+			name: "killed",
+			fail: func(p *process, dir string) {
+				_ = p.cmd.Process.Kill()
+			},
+		},
+		{
+			name: "unhealthy",
+			fail: func(p *process, dir string) {
+				_ = ioutil.WriteFile(filepath.Join(dir, "unhealthy"), nil, 0644)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			p, dir := newHelperProcess(t, os.Args[0])
+			s := newStorage(p)
+			assert.Nil(t, p.start(ctx))
+			assert.False(t, p.getCapabilities().GetMock())
+
+			test.fail(p, dir)
+			select {
+			case <-s.GetAnnouncement():
+			case <-time.After(10 * time.Second):
+				t.Fatal("storage is not announced after the plugin is restarted")
+			}
+			assert.Equal(t, 2, getLaunches(dir))
+			assert.True(t, p.getCapabilities().GetMock())
+		})
+	}
+}
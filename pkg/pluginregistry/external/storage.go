@@ -0,0 +1,121 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"time"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry"
+)
+
+var _ pluginregistry.StoragePlugin = &storage{}
+
+// watchRetryInterval is the interval of reconnecting StorageWatch after it is broken
+const watchRetryInterval = time.Second
+
+// storage implements the storage plugin by an external plugin
+type storage struct {
+	process      *process
+	announcement chan struct{}
+}
+
+func newStorage(p *process) *storage {
+	s := &storage{
+		process:      p,
+		announcement: make(chan struct{}, 1),
+	}
+	// the plugin may lose its data after it is restarted, so all key-val pairs are reloaded
+	p.onRestarted = append(p.onRestarted, s.announce)
+	return s
+}
+
+// Name is used to return the plugin name
+func (s *storage) Name() string {
+	return "external(" + s.process.cfg.Name + ")"
+}
+
+// Set is used to set key-val pair
+func (s *storage) Set(ctx context.Context, key string, val string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.process.cfg.Timeout)
+	defer cancel()
+	_, err := s.process.client.StorageSet(ctx, &v1alpha1.PluginStorageSetRequest{Key: key, Val: val})
+	return err
+}
+
+// Delete is used to delete specified key
+func (s *storage) Delete(ctx context.Context, key string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.process.cfg.Timeout)
+	defer cancel()
+	_, err := s.process.client.StorageDelete(ctx, &v1alpha1.PluginStorageDeleteRequest{Key: key})
+	return err
+}
+
+// List is used to list all key-val pairs in storage
+func (s *storage) List(ctx context.Context) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.process.cfg.Timeout)
+	defer cancel()
+	resp, err := s.process.client.StorageList(ctx, &v1alpha1.PluginStorageListRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetPairs() == nil {
+		return map[string]string{}, nil
+	}
+	return resp.GetPairs(), nil
+}
+
+// GetAnnouncement is used to get announcement
+func (s *storage) GetAnnouncement() chan struct{} {
+	return s.announcement
+}
+
+// Start is used to watch the events of plugin in the background, the watch is reconnected after it is broken
+func (s *storage) Start(ctx context.Context, cancelFunc context.CancelFunc) error {
+	go func() {
+		for {
+			if err := s.watch(ctx); err != nil && ctx.Err() == nil {
+				s.process.LogWarn(nil, "failed to watch external storage: %s", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchRetryInterval):
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *storage) watch(ctx context.Context) error {
+	stream, err := s.process.client.StorageWatch(ctx, &v1alpha1.PluginStorageWatchRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			return err
+		}
+		s.announce()
+	}
+}
+
+func (s *storage) announce() {
+	select {
+	case s.announcement <- struct{}{}:
+	default:
+	}
+}
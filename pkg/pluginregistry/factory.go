@@ -0,0 +1,143 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginregistry
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/protomanager"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// PluginConfig defines the config of plugins created by factories
+type PluginConfig interface {
+	IsEnabled() bool
+	RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet)
+	Validate() error
+}
+
+// Dependencies defines the components which plugins may depend on
+type Dependencies struct {
+	// GetBlob is used to obtain the uploaded blob of a given name
+	GetBlob func(name string) (*v1alpha1.Blob, bool)
+	// ProtoManager is nil if the gRPC mock server is disabled
+	ProtoManager protomanager.Provider
+}
+
+// Factory is used to create plugins of a kind
+type Factory interface {
+	// NewConfig is used to init config with default values
+	NewConfig() PluginConfig
+	// New is used to create the plugin, which implements one or more of MockPlugin, MatchPlugin and StoragePlugin
+	// It returns nil if the plugin is unavailable with the given dependencies
+	New(cfg PluginConfig, deps *Dependencies, logger logger.Logger, registerer prometheus.Registerer) (Plugin, error)
+}
+
+// Starter is an optional interface of plugins which should be started before serving, such as external plugins
+type Starter interface {
+	Start(ctx context.Context, cancelFunc context.CancelFunc) error
+}
+
+// StorageProvider is an optional interface of plugins which provide a storage plugin according to their configs
+type StorageProvider interface {
+	// StoragePlugin returns nil if the plugin does not provide a storage plugin
+	StoragePlugin() StoragePlugin
+}
+
+// Constructor is used to create the plugin with the config of type C,
+// a nil plugin is returned if the plugin is unavailable with the given dependencies
+type Constructor[C PluginConfig, P Plugin] func(cfg C, deps *Dependencies, logger logger.Logger, registerer prometheus.Registerer) (P, error)
+
+// Register is used to register the factory of plugins with name by the constructors of config and plugin,
+// see RegisterFactory for the usage of name
+func Register[C PluginConfig, P Plugin](name string, newConfig func() C, newPlugin Constructor[C, P]) {
+	RegisterFactory(name, NewFactory(newConfig, newPlugin))
+}
+
+// NewFactory is used to create the factory of plugins by the constructors of config and plugin
+func NewFactory[C PluginConfig, P Plugin](newConfig func() C, newPlugin Constructor[C, P]) Factory {
+	return &factory[C, P]{newConfig: newConfig, newPlugin: newPlugin}
+}
+
+// factory implements Factory by the constructors of config and plugin
+type factory[C PluginConfig, P Plugin] struct {
+	newConfig func() C
+	newPlugin Constructor[C, P]
+}
+
+// NewConfig is used to init config with default values
+func (f *factory[C, P]) NewConfig() PluginConfig {
+	return f.newConfig()
+}
+
+// New is used to create the plugin
+func (f *factory[C, P]) New(cfg PluginConfig, deps *Dependencies, logger logger.Logger, registerer prometheus.Registerer) (Plugin, error) {
+	config, ok := cfg.(C)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of config: %T", cfg)
+	}
+	plugin, err := f.newPlugin(config, deps, logger, registerer)
+	if err != nil {
+		return nil, err
+	}
+	// the plugin is unavailable if the constructor returns a nil pointer
+	if v := reflect.ValueOf(plugin); !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil, nil
+	}
+	return plugin, nil
+}
+
+var (
+	factoriesLock sync.RWMutex
+	factories     = map[string]Factory{}
+)
+
+// RegisterFactory is used to register the factory of plugins with name, which is used in the plugin chain
+// and as the key of plugin config. Names are case-insensitive, and registering a name again replaces the factory,
+// such as the script factory with v8 engine. It is usually called by Register in the init function of plugin packages
+func RegisterFactory(name string, factory Factory) {
+	factoriesLock.Lock()
+	defer factoriesLock.Unlock()
+	factories[strings.ToLower(name)] = factory
+}
+
+// GetFactory is used to get the factory of name
+func GetFactory(name string) (Factory, bool) {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+	factory, ok := factories[strings.ToLower(name)]
+	return factory, ok
+}
+
+// FactoryNames is used to return the sorted names of registered factories
+func FactoryNames() []string {
+	factoriesLock.RLock()
+	defer factoriesLock.RUnlock()
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
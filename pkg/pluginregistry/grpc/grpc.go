@@ -25,6 +25,8 @@ import (
 	"github.com/jhump/protoreflect/dynamic"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
+	// register messages of google.rpc which are commonly used as details of status
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
 
 	"github.com/bilibili-base/powermock/apis/v1alpha1"
 	"github.com/bilibili-base/powermock/pkg/interact"
@@ -37,12 +39,16 @@ var _ pluginregistry.MockPlugin = &Plugin{}
 // MethodDescGetter is used to obtain the MethodDescriptor corresponding to a given method
 type MethodDescGetter func(method string) (*desc.MethodDescriptor, bool)
 
+// MessageDescGetter is used to obtain the MessageDescriptor corresponding to a given fully qualified name
+type MessageDescGetter func(name string) (*desc.MessageDescriptor, bool)
+
 // Plugin implements Mock for gRPC request
 type Plugin struct {
 	cfg *Config
 
-	methodDescGetter MethodDescGetter
-	registerer       prometheus.Registerer
+	methodDescGetter  MethodDescGetter
+	messageDescGetter MessageDescGetter
+	registerer        prometheus.Registerer
 	logger.Logger
 }
 
@@ -73,16 +79,32 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func init() {
+	pluginregistry.Register("grpc", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+		// the plugin is unavailable if the gRPC mock server is disabled
+		if deps.ProtoManager == nil {
+			return nil, nil
+		}
+		return New(cfg, deps.ProtoManager.GetMethod, deps.ProtoManager.GetMessage, logger, registerer)
+	})
+}
+
 // New is used to init service
-func New(cfg *Config, methodDescGetter MethodDescGetter, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+func New(cfg *Config, methodDescGetter MethodDescGetter, messageDescGetter MessageDescGetter,
+	logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
 	if methodDescGetter == nil {
 		return nil, errors.New("method descriptor getter is required")
 	}
+	if messageDescGetter == nil {
+		return nil, errors.New("message descriptor getter is required")
+	}
 	service := &Plugin{
-		cfg:              cfg,
-		methodDescGetter: methodDescGetter,
-		registerer:       registerer,
-		Logger:           logger.NewLogger("gRPCPlugin"),
+		cfg:               cfg,
+		methodDescGetter:  methodDescGetter,
+		messageDescGetter: messageDescGetter,
+		registerer:        registerer,
+		Logger:            logger.NewLogger("gRPCPlugin"),
 	}
 	return service, nil
 }
@@ -94,22 +116,85 @@ func (s *Plugin) Name() string {
 
 // MockResponse is used to generate interact.Response according to the given MockAPI_Response and interact.Request
 func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request, response *interact.Response) (abort bool, err error) {
-	if request.Protocol != interact.ProtocolGRPC {
+	if !request.Protocol.IsGRPC() {
+		return false, nil
+	}
+	// error responses contain no message, only details of status are converted
+	if response.Code != 0 {
+		if err := s.convertStatus(response.Status); err != nil {
+			return true, err
+		}
+		return false, nil
+	}
+	// binary content is sent as is without conversion
+	if response.Content != nil {
+		data, err := response.Content.ReadAll()
+		if err != nil {
+			return true, err
+		}
+		response.Body = interact.NewBytesMessage(data)
+		response.Content = nil
 		return false, nil
 	}
 	md, ok := s.methodDescGetter(request.Path)
 	if !ok {
 		return true, fmt.Errorf("unable to find descriptor: %s", request.Path)
 	}
-	data := response.Body.Bytes()
-	message := dynamic.NewMessage(md.GetOutputType())
+	if len(response.Stream) > 0 {
+		for _, message := range response.Stream {
+			body, err := convertBody(md.GetOutputType(), message.Body)
+			if err != nil {
+				return true, err
+			}
+			message.Body = body
+		}
+		return false, nil
+	}
+	body, err := convertBody(md.GetOutputType(), response.Body)
+	if err != nil {
+		return true, err
+	}
+	response.Body = body
+	return false, nil
+}
+
+// convertStatus is used to convert the JSON details of status to the binary format of their types
+func (s *Plugin) convertStatus(status *interact.Status) error {
+	if status == nil {
+		return nil
+	}
+	for _, detail := range status.Details {
+		messageType, ok := s.messageDescGetter(detail.Type)
+		if !ok {
+			// well-known types, such as messages of google.rpc, are not included in the loaded proto files
+			var err error
+			messageType, err = desc.LoadMessageDescriptor(detail.Type)
+			if err != nil || messageType == nil {
+				return fmt.Errorf("unable to find descriptor of status detail: %s", detail.Type)
+			}
+		}
+		body, err := convertBody(messageType, detail.Body)
+		if err != nil {
+			return multierror.Prefix(err, fmt.Sprintf("status detail %s:", detail.Type))
+		}
+		detail.Body = body
+	}
+	return nil
+}
+
+// convertBody is used to convert the JSON body to the binary format of the given message type
+func convertBody(messageType *desc.MessageDescriptor, body interact.Message) (interact.Message, error) {
+	var data []byte
+	if body != nil {
+		data = body.Bytes()
+	}
+	message := dynamic.NewMessage(messageType)
 	if err := message.UnmarshalJSONPB(&jsonpb.Unmarshaler{}, data); err != nil {
-		return true, multierror.Prefix(err, "failed to unmarshal:")
+		return nil, multierror.Prefix(err, "failed to unmarshal:")
 	}
 	binaryData, err := message.Marshal()
 	if err != nil {
-		return true, multierror.Prefix(err, "failed to marshal:")
+		return nil, multierror.Prefix(err, "failed to marshal:")
 	}
-	response.Body = interact.NewBytesMessage(binaryData)
-	return false, nil
+	return interact.NewBytesMessage(binaryData), nil
 }
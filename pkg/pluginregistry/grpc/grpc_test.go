@@ -0,0 +1,113 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func TestMockResponseStatus(t *testing.T) {
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"quota.proto": `syntax = "proto3"; package test; message Quota { string user = 1; int32 limit = 2; }`,
+		}),
+	}
+	fds, err := parser.ParseFiles("quota.proto")
+	assert.Nil(t, err)
+	plugin, err := New(NewConfig(), func(method string) (*desc.MethodDescriptor, bool) {
+		return nil, false
+	}, func(name string) (*desc.MessageDescriptor, bool) {
+		message := fds[0].FindMessage(name)
+		return message, message != nil
+	}, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+
+	quota := dynamic.NewMessage(fds[0].FindMessage("test.Quota"))
+	quota.SetFieldByName("user", "powermock")
+	quota.SetFieldByName("limit", int32(10))
+	tests := []struct {
+		name      string
+		details   []*interact.StatusDetail
+		expect    []proto.Message
+		expectErr bool
+	}{
+		{
+			name: "well-known types",
+			details: []*interact.StatusDetail{
+				{Type: "google.rpc.ErrorInfo", Body: interact.NewBytesMessage([]byte(`{"reason": "NOT_FOUND", "domain": "powermock"}`))},
+				{Type: "google.rpc.RetryInfo", Body: interact.NewBytesMessage([]byte(`{"retryDelay": "1s"}`))},
+			},
+			expect: []proto.Message{
+				&errdetails.ErrorInfo{Reason: "NOT_FOUND", Domain: "powermock"},
+				&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)},
+			},
+		},
+		{
+			name: "loaded types",
+			details: []*interact.StatusDetail{
+				{Type: "test.Quota", Body: interact.NewBytesMessage([]byte(`{"user": "powermock", "limit": 10}`))},
+			},
+			expect: []proto.Message{quota},
+		},
+		{
+			name: "unknown type",
+			details: []*interact.StatusDetail{
+				{Type: "test.Unknown", Body: interact.NewBytesMessage([]byte(`{}`))},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid body",
+			details: []*interact.StatusDetail{
+				{Type: "test.Quota", Body: interact.NewBytesMessage([]byte(`{"limit": "many"}`))},
+			},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		request := &interact.Request{Protocol: interact.ProtocolGRPC, Path: "/test.Greeter/Hello"}
+		response := &interact.Response{Code: 5, Status: &interact.Status{Message: "error", Details: test.details}}
+		_, err := plugin.MockResponse(context.TODO(), nil, request, response)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		for i, detail := range response.Status.Details {
+			expect, err := proto.Marshal(test.expect[i])
+			assert.Nil(t, err, test.name)
+			assert.Equal(t, expect, detail.Body.Bytes(), detail.Type)
+		}
+	}
+
+	// error responses without status are sent as is
+	response := &interact.Response{Code: 5}
+	_, err = plugin.MockResponse(context.TODO(), nil, &interact.Request{Protocol: interact.ProtocolGRPC}, response)
+	assert.Nil(t, err)
+}
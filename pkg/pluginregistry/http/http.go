@@ -63,6 +63,13 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func init() {
+	pluginregistry.Register("http", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+		return New(cfg, logger, registerer)
+	})
+}
+
 // New is used to init service
 func New(cfg *Config, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
 	service := &Plugin{
@@ -83,6 +90,9 @@ func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Respon
 	if request.Protocol != interact.ProtocolHTTP {
 		return false, nil
 	}
-	response.Header["content-type"] = "application/json"
+	// the content type of binary content is detected by the mock server
+	if response.Content == nil && response.Header.Get("content-type") == "" {
+		response.Header.Set("content-type", "application/json")
+	}
 	return false, nil
 }
@@ -0,0 +1,53 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func TestMockResponse(t *testing.T) {
+	plugin, err := New(NewConfig(), logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	tests := []struct {
+		name     string
+		protocol interact.Protocol
+		header   map[string][]string
+		content  *interact.Content
+		expect   string
+	}{
+		{name: "default", protocol: interact.ProtocolHTTP, expect: "application/json"},
+		{name: "grpc", protocol: interact.ProtocolGRPC, expect: ""},
+		{name: "specified", protocol: interact.ProtocolHTTP, header: map[string][]string{"Content-Type": {"text/event-stream"}}, expect: "text/event-stream"},
+		{name: "binary content", protocol: interact.ProtocolHTTP, content: &interact.Content{Name: "a.png"}, expect: ""},
+	}
+	for _, test := range tests {
+		request := &interact.Request{Protocol: test.protocol}
+		response := interact.NewDefaultResponse(request)
+		response.Header = interact.NewValues(test.header)
+		response.Content = test.content
+		abort, err := plugin.MockResponse(context.TODO(), nil, request, response)
+		assert.Nil(t, err, test.name)
+		assert.False(t, abort, test.name)
+		assert.Equal(t, test.expect, response.Header.Get("content-type"), test.name)
+	}
+}
@@ -27,3 +27,10 @@ type MatchPlugin interface {
 	Plugin
 	Match(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (match bool, err error)
 }
+
+// ConditionValidator is an optional interface of MatchPlugin
+// It is used to validate conditions when MockAPIs are saved, so that errors such as unknown operators
+// are reported at save time instead of at matching time
+type ConditionValidator interface {
+	ValidateCondition(condition *v1alpha1.MockAPI_Condition) error
+}
@@ -27,3 +27,10 @@ type MockPlugin interface {
 	Plugin
 	MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request, response *interact.Response) (abort bool, err error)
 }
+
+// ResponseValidator is an optional interface of MockPlugin
+// It is used to validate responses when MockAPIs are saved, so that errors such as unknown external plugins
+// are reported at save time instead of at mocking time
+type ResponseValidator interface {
+	ValidateResponse(response *v1alpha1.MockAPI_Response) error
+}
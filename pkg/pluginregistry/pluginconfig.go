@@ -0,0 +1,96 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginregistry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+// PluginConfigs defines the configs of plugins keyed by the names of factories,
+// such as plugin.simple and plugin.redis in the config file
+type PluginConfigs map[string]PluginConfig
+
+// NewPluginConfigs is used to init the configs of all registered factories with default values
+func NewPluginConfigs() PluginConfigs {
+	configs := PluginConfigs{}
+	for _, name := range FactoryNames() {
+		factory, _ := GetFactory(name)
+		configs[name] = factory.NewConfig()
+	}
+	return configs
+}
+
+// Get is used to get the config of name
+func (c PluginConfigs) Get(name string) (PluginConfig, bool) {
+	cfg, ok := c[strings.ToLower(name)]
+	return cfg, ok
+}
+
+// RegisterFlagsWithPrefix is used to register flags
+func (c PluginConfigs) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
+	for _, name := range c.names() {
+		c[name].RegisterFlagsWithPrefix(prefix+"plugin.", f)
+	}
+}
+
+// Validate is used to validate config and returns error on failure
+func (c PluginConfigs) Validate() error {
+	for _, name := range c.names() {
+		if err := c[name].Validate(); err != nil {
+			return fmt.Errorf("plugin(%s): %s", name, err)
+		}
+	}
+	return nil
+}
+
+// UnmarshalYAML is used to decode the config of each plugin into the config created by its factory,
+// so that unspecified fields keep the default values
+func (c *PluginConfigs) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw map[string]interface{}
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+	if *c == nil {
+		*c = NewPluginConfigs()
+	}
+	for name, value := range raw {
+		cfg, ok := c.Get(name)
+		if !ok {
+			return fmt.Errorf("unknown plugin: %s", name)
+		}
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+			return fmt.Errorf("plugin(%s): %s", name, err)
+		}
+	}
+	return nil
+}
+
+func (c PluginConfigs) names() []string {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -19,3 +19,10 @@ package pluginregistry
 type Plugin interface {
 	Name() string
 }
+
+// ScriptLanguageProvider is an optional interface of plugins which handle ScriptCondition or ScriptResponse
+// It is used to reject the scripts of unsupported languages when MockAPIs are saved
+type ScriptLanguageProvider interface {
+	// ScriptLanguages returns the languages handled by the plugin, such as javascript and lua
+	ScriptLanguages() []string
+}
@@ -15,6 +15,9 @@
 package pluginregistry
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -39,8 +42,18 @@ type Registry interface {
 	StoragePlugin() StoragePlugin
 	// RegisterStoragePlugin is used to register storage plugin
 	RegisterStoragePlugin(StoragePlugin) error
+
+	// Install is used to create the enabled plugins of chain by registered factories,
+	// and register them in the order of chain
+	Install(configs PluginConfigs, deps *Dependencies) error
+	// Start is used to start the installed plugins which implement Starter, and then the storage plugin
+	Start(ctx context.Context, cancelFunc context.CancelFunc) error
 }
 
+// DefaultChain is the plugin chain used if the chain is not specified,
+// the plugins of other registered factories are appended in the order of names
+var DefaultChain = []string{"redis", "rediscluster", "simple", "script", "cel", "wasm", "external", "grpc", "http"}
+
 // BasicRegistry is the basic implementation of pluginRegistry
 type BasicRegistry struct {
 	cfg *Config
@@ -48,6 +61,7 @@ type BasicRegistry struct {
 	matchPlugins  []MatchPlugin
 	mockPlugins   []MockPlugin
 	storagePlugin StoragePlugin
+	starters      []Starter
 	registerer    prometheus.Registerer
 	lock          sync.Mutex
 
@@ -55,7 +69,11 @@ type BasicRegistry struct {
 }
 
 // Config defines the config structure
-type Config struct{}
+type Config struct {
+	// Chain is the names of plugins in order, MockPlugins and MatchPlugins are called in the order,
+	// and the first storage plugin is used. Plugins not in the chain are not created
+	Chain []string
+}
 
 // NewConfig is used to init config with default values
 func NewConfig() *Config {
@@ -64,13 +82,42 @@ func NewConfig() *Config {
 
 // RegisterFlagsWithPrefix is used to register flags
 func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
+	f.StringSliceVar(&c.Chain, prefix+"pluginRegistry.chain", c.Chain, "names of plugins in order, the default chain is used if it is empty")
 }
 
 // Validate is used to validate config and returns error on failure
 func (c *Config) Validate() error {
+	names := map[string]bool{}
+	for _, name := range c.Chain {
+		if _, ok := GetFactory(name); !ok {
+			return fmt.Errorf("unknown plugin in chain: %s", name)
+		}
+		if names[strings.ToLower(name)] {
+			return fmt.Errorf("plugin %s is in chain more than once", name)
+		}
+		names[strings.ToLower(name)] = true
+	}
 	return nil
 }
 
+// GetChain is used to return the chain, or the default chain if it is not specified
+func (c *Config) GetChain() []string {
+	if len(c.Chain) > 0 {
+		return c.Chain
+	}
+	chain := append([]string{}, DefaultChain...)
+	included := map[string]bool{}
+	for _, name := range chain {
+		included[strings.ToLower(name)] = true
+	}
+	for _, name := range FactoryNames() {
+		if !included[name] {
+			chain = append(chain, name)
+		}
+	}
+	return chain
+}
+
 // New is used to init service
 func New(cfg *Config, logger logger.Logger, registerer prometheus.Registerer) (Registry, error) {
 	service := &BasicRegistry{
@@ -121,3 +168,82 @@ func (b *BasicRegistry) RegisterStoragePlugin(plugin StoragePlugin) error {
 	b.storagePlugin = plugin
 	return nil
 }
+
+// Install is used to create the enabled plugins of chain by registered factories,
+// and register them in the order of chain
+func (b *BasicRegistry) Install(configs PluginConfigs, deps *Dependencies) error {
+	for _, name := range b.cfg.GetChain() {
+		factory, ok := GetFactory(name)
+		if !ok {
+			b.LogWarn(map[string]interface{}{"plugin": name}, "plugin is skipped because its factory is not registered")
+			continue
+		}
+		cfg, ok := configs.Get(name)
+		if !ok {
+			cfg = factory.NewConfig()
+		}
+		if !cfg.IsEnabled() {
+			continue
+		}
+		b.LogInfo(nil, "* start to create plugin(%s)", name)
+		plugin, err := factory.New(cfg, deps, b.Logger, b.registerer)
+		if err != nil {
+			return fmt.Errorf("failed to create plugin(%s): %s", name, err)
+		}
+		if plugin == nil {
+			b.LogInfo(nil, "plugin(%s) is unavailable and skipped", name)
+			continue
+		}
+		if err := b.install(plugin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BasicRegistry) install(plugin Plugin) error {
+	if p, ok := plugin.(MockPlugin); ok {
+		if err := b.RegisterMockPlugins(p); err != nil {
+			return err
+		}
+	}
+	if p, ok := plugin.(MatchPlugin); ok {
+		if err := b.RegisterMatchPlugins(p); err != nil {
+			return err
+		}
+	}
+	storage, isStorage := plugin.(StoragePlugin)
+	if provider, ok := plugin.(StorageProvider); ok && !isStorage {
+		storage = provider.StoragePlugin()
+	}
+	if storage != nil {
+		if b.storagePlugin != nil {
+			b.LogWarn(nil, "storage plugin(%s) is ignored because storage plugin(%s) is used",
+				storage.Name(), b.storagePlugin.Name())
+		} else if err := b.RegisterStoragePlugin(storage); err != nil {
+			return err
+		}
+	}
+	if p, ok := plugin.(Starter); ok && !isStorage {
+		b.lock.Lock()
+		b.starters = append(b.starters, p)
+		b.lock.Unlock()
+	}
+	return nil
+}
+
+// Start is used to start the installed plugins which implement Starter, and then the storage plugin
+func (b *BasicRegistry) Start(ctx context.Context, cancelFunc context.CancelFunc) error {
+	for _, starter := range b.starters {
+		if err := starter.Start(ctx, cancelFunc); err != nil {
+			return err
+		}
+	}
+	if b.storagePlugin != nil {
+		b.LogInfo(nil, "* start to start storage plugin(%s)", b.storagePlugin.Name())
+		if err := b.storagePlugin.Start(ctx, cancelFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
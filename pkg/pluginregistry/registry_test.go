@@ -0,0 +1,127 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginregistry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+type testConfig struct {
+	Enable bool
+	Value  string
+}
+
+func (c *testConfig) IsEnabled() bool                                         { return c.Enable }
+func (c *testConfig) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {}
+func (c *testConfig) Validate() error                                         { return nil }
+
+type testPlugin struct {
+	name string
+}
+
+func (p *testPlugin) Name() string { return p.name }
+
+func (p *testPlugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response,
+	request *interact.Request, response *interact.Response) (bool, error) {
+	return false, nil
+}
+
+type testFactory struct {
+	name string
+}
+
+func (f testFactory) NewConfig() PluginConfig {
+	return &testConfig{Enable: true, Value: "default"}
+}
+
+func (f testFactory) New(cfg PluginConfig, deps *Dependencies, logger logger.Logger, registerer prometheus.Registerer) (Plugin, error) {
+	return &testPlugin{name: f.name + ":" + cfg.(*testConfig).Value}, nil
+}
+
+func TestInstall(t *testing.T) {
+	RegisterFactory("testA", testFactory{name: "a"})
+	RegisterFactory("testB", testFactory{name: "b"})
+	RegisterFactory("testC", testFactory{name: "c"})
+
+	var configs PluginConfigs
+	err := yaml.UnmarshalStrict([]byte("testa: {value: x}\ntestc: {enable: false}\n"), &configs)
+	assert.Nil(t, err)
+	err = yaml.UnmarshalStrict([]byte("unknown: {}\n"), &configs)
+	assert.NotNil(t, err)
+
+	log, err := logger.New(logger.NewConfig(), "test", prometheus.NewRegistry())
+	assert.Nil(t, err)
+	registry, err := New(&Config{Chain: []string{"testB", "testC", "testA"}}, log, prometheus.NewRegistry())
+	assert.Nil(t, err)
+	assert.Nil(t, registry.Install(configs, &Dependencies{}))
+
+	var names []string
+	for _, plugin := range registry.MockPlugins() {
+		names = append(names, plugin.Name())
+	}
+	assert.Equal(t, []string{"b:default", "a:x"}, names)
+	assert.Empty(t, registry.MatchPlugins())
+	assert.Nil(t, registry.StoragePlugin())
+}
+
+func TestRegister(t *testing.T) {
+	newConfig := func() *testConfig {
+		return &testConfig{Enable: true, Value: "default"}
+	}
+	Register("testD", newConfig, func(cfg *testConfig, deps *Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*testPlugin, error) {
+		// the plugin is unavailable without value
+		if cfg.Value == "" {
+			return nil, nil
+		}
+		return &testPlugin{name: "d:" + cfg.Value}, nil
+	})
+	factory, ok := GetFactory("testd")
+	assert.True(t, ok)
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "default", want: "d:default"},
+		{value: "", want: ""},
+	}
+	for _, test := range tests {
+		cfg := factory.NewConfig()
+		cfg.(*testConfig).Value = test.value
+		plugin, err := factory.New(cfg, &Dependencies{}, logger.NewDefault("test"), prometheus.NewRegistry())
+		assert.Nil(t, err)
+		if test.want == "" {
+			assert.Nil(t, plugin)
+			continue
+		}
+		assert.Equal(t, test.want, plugin.Name())
+	}
+	type otherConfig struct {
+		testConfig
+	}
+	_, err := factory.New(&otherConfig{}, &Dependencies{}, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.NotNil(t, err)
+}
@@ -24,6 +24,19 @@ import (
 	"github.com/bilibili-base/powermock/pkg/interact"
 )
 
+// Engine is the javascript engine implemented by v8
+type Engine struct{}
+
+// MatchRequest is used to match mock case by javascript
+func (Engine) MatchRequest(ctx context.Context, request *interact.Request, script string) (bool, error) {
+	return MatchRequestByJavascript(ctx, request, script)
+}
+
+// MockResponse is used to mock response by javascript
+func (Engine) MockResponse(ctx context.Context, request *interact.Request, response *interact.Response, script string) error {
+	return MockResponseByJavascript(ctx, request, response, script)
+}
+
 // MatchRequestByJavascript is used to match mock case by javascript
 func MatchRequestByJavascript(ctx context.Context, request *interact.Request, script string) (bool, error) {
 	vm, err := v8go.NewContext()
@@ -40,8 +40,8 @@ func TestMatchRequestByJavascript(t *testing.T) {
 				ctx: context.Background(),
 				request: &interact.Request{
 					Method: "POST",
-					Header: map[string]string{
-						"x-user-id": "320482",
+					Header: interact.Values{
+						"x-user-id": {"320482"},
 					},
 				},
 				script: `
@@ -62,8 +62,8 @@ func TestMatchRequestByJavascript(t *testing.T) {
 				ctx: context.Background(),
 				request: &interact.Request{
 					Method: "POST",
-					Header: map[string]string{
-						"x-user-id": "320481",
+					Header: interact.Values{
+						"x-user-id": {"320481"},
 					},
 				},
 				script: `
@@ -112,8 +112,8 @@ func TestMockResponseByJavascript(t *testing.T) {
 				ctx: context.Background(),
 				request: &interact.Request{
 					Method: "POST",
-					Header: map[string]string{
-						"x-user-id": "320482",
+					Header: interact.Values{
+						"x-user-id": {"320482"},
 					},
 				},
 				response: &interact.Response{
@@ -134,9 +134,9 @@ func TestMockResponseByJavascript(t *testing.T) {
 			},
 			want: &interact.Response{
 				Code: 200,
-				Header: map[string]string{
-					"x-service-token": "micro-320482",
-					"x-trace-id":      "j92e210u90",
+				Header: interact.Values{
+					"x-service-token": {"micro-320482"},
+					"x-trace-id":      {"j92e210u90"},
 				},
 				Body:    interact.NewBytesMessage([]byte(`{"message":"OK","code":200}`)),
 				Trailer: nil,
@@ -0,0 +1,117 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gojacore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dop251/goja"
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+// Engine is the pure Go javascript engine implemented by goja
+type Engine struct{}
+
+// MatchRequest is used to match mock case by javascript
+func (Engine) MatchRequest(ctx context.Context, request *interact.Request, script string) (bool, error) {
+	return MatchRequestByJavascript(ctx, request, script)
+}
+
+// MockResponse is used to mock response by javascript
+func (Engine) MockResponse(ctx context.Context, request *interact.Request, response *interact.Response, script string) error {
+	return MockResponseByJavascript(ctx, request, response, script)
+}
+
+// MatchRequestByJavascript is used to match mock case by javascript
+func MatchRequestByJavascript(ctx context.Context, request *interact.Request, script string) (bool, error) {
+	vm := goja.New()
+	requestRaw, err := jsoniter.MarshalToString(request)
+	if err != nil {
+		return false, err
+	}
+	_, err = RunScript(ctx, vm, fmt.Sprintf("const request = %s", requestRaw))
+	if err != nil {
+		return false, err
+	}
+	value, err := RunScript(ctx, vm, script)
+	if err != nil {
+		return false, err
+	}
+	return value.ToBoolean(), nil
+}
+
+// MockResponseByJavascript is used to mock response by javascript
+func MockResponseByJavascript(ctx context.Context, request *interact.Request, response *interact.Response, script string) error {
+	vm := goja.New()
+	requestRaw, err := jsoniter.MarshalToString(request)
+	if err != nil {
+		return err
+	}
+	_, err = RunScript(ctx, vm, fmt.Sprintf("const request = %s", requestRaw))
+	if err != nil {
+		return err
+	}
+	value, err := RunScript(ctx, vm, script)
+	if err != nil {
+		return err
+	}
+	responseRaw, err := marshalJSON(vm, value)
+	if err != nil {
+		return err
+	}
+	err = jsoniter.Unmarshal(responseRaw, &response)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RunScript is used to run javascript with context
+// The execution is interrupted when the context is done
+func RunScript(ctx context.Context, vm *goja.Runtime, script string) (goja.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			vm.Interrupt(ctx.Err())
+		case <-done:
+		}
+	}()
+	return vm.RunScript("main.js", script)
+}
+
+// marshalJSON is used to encode value by JSON.stringify, so that the result is the same as v8
+func marshalJSON(vm *goja.Runtime, value goja.Value) ([]byte, error) {
+	stringify, ok := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+	if !ok {
+		return nil, errors.New("JSON.stringify is not a function")
+	}
+	result, err := stringify(goja.Undefined(), value)
+	if err != nil {
+		return nil, err
+	}
+	if goja.IsUndefined(result) {
+		return []byte(`null`), nil
+	}
+	return []byte(result.String()), nil
+}
@@ -0,0 +1,275 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package luacore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	jsoniter "github.com/json-iterator/go"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+// Engine is the lua engine implemented by gopher-lua
+type Engine struct{}
+
+// MatchRequest is used to match mock case by lua
+func (Engine) MatchRequest(ctx context.Context, request *interact.Request, script string) (bool, error) {
+	return MatchRequestByLua(ctx, request, script)
+}
+
+// MockResponse is used to mock response by lua
+func (Engine) MockResponse(ctx context.Context, request *interact.Request, response *interact.Response, script string) error {
+	return MockResponseByLua(ctx, request, response, script)
+}
+
+// MatchRequestByLua is used to match mock case by lua, the script should return a boolean,
+// such as: return tonumber(request.header["x-user-id"]) >= 1000
+func MatchRequestByLua(ctx context.Context, request *interact.Request, script string) (bool, error) {
+	L, err := newState(request)
+	if err != nil {
+		return false, err
+	}
+	defer L.Close()
+	value, err := RunScript(ctx, L, script)
+	if err != nil {
+		return false, err
+	}
+	return lua.LVAsBool(value), nil
+}
+
+// MockResponseByLua is used to mock response by lua, the script should return a table in the same shape as
+// the response of javascript, such as: return {code = 200, header = {["x-id"] = "1"}, body = {message = "OK"}}
+func MockResponseByLua(ctx context.Context, request *interact.Request, response *interact.Response, script string) error {
+	L, err := newState(request)
+	if err != nil {
+		return err
+	}
+	defer L.Close()
+	value, err := RunScript(ctx, L, script)
+	if err != nil {
+		return err
+	}
+	generic, err := toGo(value)
+	if err != nil {
+		return err
+	}
+	responseRaw, err := json.Marshal(generic)
+	if err != nil {
+		return err
+	}
+	err = jsoniter.Unmarshal(responseRaw, &response)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RunScript is used to run lua with context and return the first returned value
+// The execution is interrupted when the context is done
+func RunScript(ctx context.Context, L *lua.LState, script string) (lua.LValue, error) {
+	if err := ctx.Err(); err != nil {
+		return lua.LNil, err
+	}
+	L.SetContext(ctx)
+	defer L.RemoveContext()
+	fn, err := L.LoadString(script)
+	if err != nil {
+		return lua.LNil, err
+	}
+	L.Push(fn)
+	if err := L.PCall(0, 1, nil); err != nil {
+		return lua.LNil, err
+	}
+	value := L.Get(-1)
+	L.Pop(1)
+	return value, nil
+}
+
+// newState is used to create a sandboxed lua state with the global variable request,
+// libraries which access files, processes or modules are not opened
+func newState(request *interact.Request) (*lua.LState, error) {
+	L := lua.NewState(lua.Options{SkipOpenLibs: true})
+	for _, lib := range []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+		{lua.OsLibName, lua.OpenOs},
+	} {
+		L.Push(L.NewFunction(lib.open))
+		L.Push(lua.LString(lib.name))
+		L.Call(1, 0)
+	}
+	for _, name := range []string{"dofile", "loadfile", "load", "loadstring", "require", "module"} {
+		L.SetGlobal(name, lua.LNil)
+	}
+	// only the functions of time are kept in os
+	os := L.NewTable()
+	for _, name := range []string{"time", "date", "clock", "difftime"} {
+		os.RawSetString(name, L.GetField(L.GetGlobal(lua.OsLibName), name))
+	}
+	L.SetGlobal(lua.OsLibName, os)
+	L.SetGlobal("json", newJSONModule(L))
+
+	requestRaw, err := jsoniter.Marshal(request)
+	if err != nil {
+		L.Close()
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(requestRaw, &generic); err != nil {
+		L.Close()
+		return nil, err
+	}
+	L.SetGlobal("request", toLua(L, generic))
+	return L, nil
+}
+
+// newJSONModule returns the table of json.encode and json.decode
+func newJSONModule(L *lua.LState) *lua.LTable {
+	module := L.NewTable()
+	L.SetFuncs(module, map[string]lua.LGFunction{
+		"encode": func(L *lua.LState) int {
+			generic, err := toGo(L.CheckAny(1))
+			if err != nil {
+				L.RaiseError("failed to encode json: %s", err)
+			}
+			data, err := json.Marshal(generic)
+			if err != nil {
+				L.RaiseError("failed to encode json: %s", err)
+			}
+			L.Push(lua.LString(data))
+			return 1
+		},
+		"decode": func(L *lua.LState) int {
+			var v interface{}
+			if err := json.Unmarshal([]byte(L.CheckString(1)), &v); err != nil {
+				L.RaiseError("failed to decode json: %s", err)
+			}
+			L.Push(toLua(L, v))
+			return 1
+		},
+	})
+	return module
+}
+
+// toLua is used to convert the generic value of JSON to lua value, arrays are converted to sequences
+func toLua(L *lua.LState, value interface{}) lua.LValue {
+	switch v := value.(type) {
+	case nil:
+		return lua.LNil
+	case bool:
+		return lua.LBool(v)
+	case float64:
+		return lua.LNumber(v)
+	case string:
+		return lua.LString(v)
+	case []interface{}:
+		table := L.CreateTable(len(v), 0)
+		for _, item := range v {
+			table.Append(toLua(L, item))
+		}
+		return table
+	case map[string]interface{}:
+		table := L.CreateTable(0, len(v))
+		for key, item := range v {
+			table.RawSetString(key, toLua(L, item))
+		}
+		return table
+	default:
+		return lua.LString(fmt.Sprint(v))
+	}
+}
+
+// toGo is used to convert lua value to the generic value of JSON,
+// tables are converted to arrays if they are non-empty sequences, otherwise objects
+// It returns an error if tables are nested in themselves or deeper than maxDepth
+func toGo(value lua.LValue) (interface{}, error) {
+	return (&converter{visiting: map[*lua.LTable]bool{}}).toGo(value, 0)
+}
+
+// maxDepth is the max depth of nested tables converted to the generic value of JSON
+const maxDepth = 100
+
+// converter tracks the tables being converted to detect cycles
+type converter struct {
+	visiting map[*lua.LTable]bool
+}
+
+func (c *converter) toGo(value lua.LValue, depth int) (interface{}, error) {
+	switch v := value.(type) {
+	case lua.LBool:
+		return bool(v), nil
+	case lua.LNumber:
+		f := float64(v)
+		if f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+			return int64(f), nil
+		}
+		return f, nil
+	case lua.LString:
+		return string(v), nil
+	case *lua.LTable:
+		if c.visiting[v] {
+			return nil, errors.New("table is nested in itself")
+		}
+		if depth >= maxDepth {
+			return nil, fmt.Errorf("tables are nested deeper than %d", maxDepth)
+		}
+		c.visiting[v] = true
+		defer delete(c.visiting, v)
+		if n := v.MaxN(); n > 0 && n == countKeys(v) {
+			result := make([]interface{}, 0, n)
+			for i := 1; i <= n; i++ {
+				item, err := c.toGo(v.RawGetInt(i), depth+1)
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, item)
+			}
+			return result, nil
+		}
+		result := map[string]interface{}{}
+		var err error
+		v.ForEach(func(key lua.LValue, item lua.LValue) {
+			if err != nil {
+				return
+			}
+			result[key.String()], err = c.toGo(item, depth+1)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		return nil, nil
+	}
+}
+
+func countKeys(table *lua.LTable) int {
+	count := 0
+	table.ForEach(func(lua.LValue, lua.LValue) {
+		count++
+	})
+	return count
+}
@@ -0,0 +1,206 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package luacore
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+func TestMatchRequestByLua(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		request *interact.Request
+		script  string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "test0",
+			args: args{
+				ctx: context.Background(),
+				request: &interact.Request{
+					Method: "POST",
+					Header: interact.Values{
+						"x-user-id": {"320482"},
+					},
+				},
+				script: `return tonumber(request.header["x-user-id"]) >= 320482`,
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "test0",
+			args: args{
+				ctx: context.Background(),
+				request: &interact.Request{
+					Method: "POST",
+					Header: interact.Values{
+						"x-user-id": {"320481"},
+					},
+				},
+				script: `return tonumber(request.header["x-user-id"]) == 320482`,
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "timeout",
+			args: args{
+				ctx:     timeoutContext(t, 100*time.Millisecond),
+				request: &interact.Request{Method: "POST"},
+				script:  `while true do end`,
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchRequestByLua(tt.args.ctx, tt.args.request, tt.args.script)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchRequestByLua() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchRequestByLua() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMockResponseByLua(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		request  *interact.Request
+		response *interact.Response
+		script   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *interact.Response
+		wantErr bool
+	}{
+		{
+			name: "test0",
+			args: args{
+				ctx: context.Background(),
+				request: &interact.Request{
+					Method: "POST",
+					Header: interact.Values{
+						"x-user-id": {"320482"},
+					},
+				},
+				response: &interact.Response{
+					Body: interact.NewBytesMessage(nil),
+				},
+				script: `
+					return {
+						code = 200,
+						header = {
+							["x-service-token"] = "micro-" .. request.header["x-user-id"],
+							["x-trace-id"] = "j92e210u90",
+						},
+						body = {message = "OK", code = 200},
+					}
+				`,
+			},
+			want: &interact.Response{
+				Code: 200,
+				Header: interact.Values{
+					"x-service-token": {"micro-320482"},
+					"x-trace-id":      {"j92e210u90"},
+				},
+				Body:    interact.NewBytesMessage([]byte(`{"code":200,"message":"OK"}`)),
+				Trailer: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "shared table",
+			args: args{
+				ctx:      context.Background(),
+				request:  &interact.Request{},
+				response: &interact.Response{Body: interact.NewBytesMessage(nil)},
+				script:   `local item = {id = 1} return {body = {a = item, b = item}}`,
+			},
+			want: &interact.Response{
+				Body: interact.NewBytesMessage([]byte(`{"a":{"id":1},"b":{"id":1}}`)),
+			},
+			wantErr: false,
+		},
+		{
+			name: "nested in itself",
+			args: args{
+				ctx:      context.Background(),
+				request:  &interact.Request{},
+				response: &interact.Response{Body: interact.NewBytesMessage(nil)},
+				script:   `local t = {} t.x = t return t`,
+			},
+			want:    &interact.Response{Body: interact.NewBytesMessage(nil)},
+			wantErr: true,
+		},
+		{
+			name: "encode table nested in itself",
+			args: args{
+				ctx:      context.Background(),
+				request:  &interact.Request{},
+				response: &interact.Response{Body: interact.NewBytesMessage(nil)},
+				script:   `local t = {1} t[2] = t return {body = json.encode(t)}`,
+			},
+			want:    &interact.Response{Body: interact.NewBytesMessage(nil)},
+			wantErr: true,
+		},
+		{
+			name: "nested too deep",
+			args: args{
+				ctx:      context.Background(),
+				request:  &interact.Request{},
+				response: &interact.Response{Body: interact.NewBytesMessage(nil)},
+				script:   `local t = {} for i = 1, 200 do t = {t} end return {body = t}`,
+			},
+			want:    &interact.Response{Body: interact.NewBytesMessage(nil)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MockResponseByLua(tt.args.ctx, tt.args.request, tt.args.response, tt.args.script)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MockResponseByLua() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(tt.args.response, tt.want) {
+				t.Errorf("MockResponseByLua() got = %v, want %v", tt.args.response, tt.want)
+			}
+		})
+	}
+}
+
+func timeoutContext(t *testing.T, timeout time.Duration) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	t.Cleanup(cancel)
+	return ctx
+}
@@ -17,6 +17,7 @@ package script
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -25,16 +26,45 @@ import (
 	"github.com/bilibili-base/powermock/apis/v1alpha1"
 	"github.com/bilibili-base/powermock/pkg/interact"
 	"github.com/bilibili-base/powermock/pkg/pluginregistry"
-	"github.com/bilibili-base/powermock/pkg/pluginregistry/script/core"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry/script/gojacore"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry/script/luacore"
 	"github.com/bilibili-base/powermock/pkg/util/logger"
 )
 
 var _ pluginregistry.MockPlugin = &Plugin{}
 var _ pluginregistry.MatchPlugin = &Plugin{}
+var _ pluginregistry.ScriptLanguageProvider = &Plugin{}
+
+// defines the supported languages of scripts
+const (
+	LangJavascript = "javascript"
+	LangLua        = "lua"
+)
+
+// defines the names of javascript engines
+const (
+	// EngineGoja is the pure Go engine, it is always available
+	EngineGoja = "goja"
+	// EngineV8 requires cgo, it is only available in the v8 binary
+	EngineV8 = "v8"
+)
+
+// matchTimeout is the time limit of running a condition
+const matchTimeout = time.Second
+
+// Engine defines the engine of a script language
+// The variable request is defined before running the script, and the result of script is used as
+// the matching result or the response, the script is terminated when the context is done
+type Engine interface {
+	MatchRequest(ctx context.Context, request *interact.Request, script string) (bool, error)
+	MockResponse(ctx context.Context, request *interact.Request, response *interact.Response, script string) error
+}
 
 // Plugin implements Mock for http request
 type Plugin struct {
 	cfg *Config
+	// languages are the engines of languages, the engine of javascript is selected by config
+	languages map[string]Engine
 
 	registerer prometheus.Registerer
 	logger.Logger
@@ -43,12 +73,15 @@ type Plugin struct {
 // Config defines the config structure
 type Config struct {
 	Enable bool
+	// Engine is the name of javascript engine, such as goja and v8
+	Engine string
 }
 
 // NewConfig is used to init config with default values
 func NewConfig() *Config {
 	return &Config{
 		Enable: true,
+		Engine: EngineGoja,
 	}
 }
 
@@ -61,6 +94,7 @@ func (c *Config) IsEnabled() bool {
 // RegisterFlagsWithPrefix is used to register flags
 func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
 	f.BoolVar(&c.Enable, prefix+"script.enable", c.Enable, "define whether the component is enabled")
+	f.StringVar(&c.Engine, prefix+"script.engine", c.Engine, "define the javascript engine, such as goja and v8")
 }
 
 // Validate is used to validate config and returns error on failure
@@ -68,10 +102,37 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func init() {
+	pluginregistry.Register("script", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+		return New(cfg, nil, logger, registerer)
+	})
+}
+
 // New is used to init service
-func New(cfg *Config, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+// engines are the javascript engines provided in addition to goja, such as v8
+func New(cfg *Config, engines map[string]Engine, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+	available := map[string]Engine{
+		EngineGoja: gojacore.Engine{},
+	}
+	for name, engine := range engines {
+		available[name] = engine
+	}
+	engine, ok := available[cfg.Engine]
+	if !ok {
+		var names []string
+		for name := range available {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown javascript engine %q, available engines: %v", cfg.Engine, names)
+	}
 	service := &Plugin{
-		cfg:        cfg,
+		cfg: cfg,
+		languages: map[string]Engine{
+			LangJavascript: engine,
+			LangLua:        luacore.Engine{},
+		},
 		registerer: registerer,
 		Logger:     logger.NewLogger("httpPlugin"),
 	}
@@ -83,16 +144,30 @@ func (s *Plugin) Name() string {
 	return "script"
 }
 
+// ScriptLanguages is used to return the languages handled by the plugin
+func (s *Plugin) ScriptLanguages() []string {
+	languages := make([]string, 0, len(s.languages))
+	for lang := range s.languages {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
 // Match is used to determine whether interact.Request satisfies the matching condition of MockAPI_Condition
 func (s *Plugin) Match(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (match bool, err error) {
 	script := condition.GetScript()
 	if script == nil {
 		return false, nil
 	}
-	if script.Lang != "javascript" {
-		return false, fmt.Errorf("script language %s is not supported yet", script.Lang)
+	// other languages are handled by other plugins, such as cel
+	engine, ok := s.languages[script.Lang]
+	if !ok {
+		return false, nil
 	}
-	return core.MatchRequestByJavascript(ctx, request, script.GetContent())
+	ctx, cancel := context.WithTimeout(ctx, matchTimeout)
+	defer cancel()
+	return engine.MatchRequest(ctx, request, script.GetContent())
 }
 
 // MockResponse is used to generate interact.Response according to the given MockAPI_Response and interact.Request
@@ -101,8 +176,10 @@ func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Respon
 	if script == nil {
 		return false, nil
 	}
-	if script.Lang != "javascript" {
-		return true, fmt.Errorf("script language %s is not supported yet", script.Lang)
+	// other languages are handled by other plugins, such as cel
+	engine, ok := s.languages[script.Lang]
+	if !ok {
+		return false, nil
 	}
 
 	// get timeout
@@ -117,7 +194,7 @@ func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Respon
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	err = core.MockResponseByJavascript(ctx, request, response, script.GetContent())
+	err = engine.MockResponse(ctx, request, response, script.GetContent())
 	if err != nil {
 		return true, err
 	}
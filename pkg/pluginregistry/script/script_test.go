@@ -0,0 +1,56 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package script
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func TestMatch(t *testing.T) {
+	plugin, err := New(NewConfig(), nil, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	tests := []struct {
+		name      string
+		lang      string
+		content   string
+		expect    bool
+		expectErr bool
+	}{
+		{name: "javascript", lang: LangJavascript, content: `request.method === "GET"`, expect: true},
+		{name: "javascript infinite loop", lang: LangJavascript, content: `while(true){}`, expectErr: true},
+		{name: "lua", lang: LangLua, content: `return request.method == "GET"`, expect: true},
+		{name: "lua infinite loop", lang: LangLua, content: `while true do end`, expectErr: true},
+		{name: "other language", lang: "cel", content: `true`, expect: false},
+	}
+	for _, test := range tests {
+		condition := &v1alpha1.MockAPI_Condition{Condition: &v1alpha1.MockAPI_Condition_Script{
+			Script: &v1alpha1.MockAPI_Condition_ScriptCondition{Lang: test.lang, Content: test.content},
+		}}
+		start := time.Now()
+		match, err := plugin.Match(context.TODO(), &interact.Request{Method: "GET"}, condition)
+		assert.Less(t, time.Since(start), 2*matchTimeout, test.name)
+		assert.Equal(t, test.expectErr, err != nil, "%s: %v", test.name, err)
+		assert.Equal(t, test.expect, match, test.name)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "sync"
+
+// maxCacheSize is the max number of entries in a cache, the cache is cleared when it is exceeded,
+// because the cached texts may be changed by MockAPIs or rendered from requests
+const maxCacheSize = 1024
+
+// cache is a map of compiled texts which is safe for concurrent use and bounded by maxCacheSize
+type cache[V any] struct {
+	lock  sync.Mutex
+	items map[string]V
+}
+
+// get is used to return the cached value of key, or compile and cache it if it is missing
+func (c *cache[V]) get(key string, compile func(key string) (V, error)) (V, error) {
+	c.lock.Lock()
+	val, ok := c.items[key]
+	c.lock.Unlock()
+	if ok {
+		return val, nil
+	}
+	val, err := compile(key)
+	if err != nil {
+		return val, err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.items == nil || len(c.items) >= maxCacheSize {
+		c.items = map[string]V{}
+	}
+	c.items[key] = val
+	return val, nil
+}
+
+// len is used to return the number of cached entries
+func (c *cache[V]) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.items)
+}
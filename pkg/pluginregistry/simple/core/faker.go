@@ -0,0 +1,199 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/brianvoe/gofakeit/v6/data"
+)
+
+// defaultFakerArgs keeps the arguments of faker functions which were used before arguments are supported
+var defaultFakerArgs = map[string][]string{
+	"price": {"0", "10000"},
+}
+
+// Fake is used to call the gofakeit function of the given name with arguments, such as number(1, 100),
+// arguments are matched with the parameters of function in order, or by name if they are written as name=value
+// The result is encoded to JSON if it is neither a string nor a number
+func Fake(ctx *Context, name string, args []string) (string, error) {
+	name = strings.ToLower(name)
+	if len(args) == 0 {
+		args = defaultFakerArgs[name]
+	}
+	r := ctx.Rand()
+	info := gofakeit.GetFuncLookup(name)
+	if info == nil {
+		return "", fmt.Errorf("unknown faker function: %s", name)
+	}
+	// date accepts the layout of Go as well as the names of layouts supported by gofakeit
+	if name == "date" && len(args) == 1 && len(info.Params) > 0 && !hasOption(info.Params[0], args[0]) {
+		return (&gofakeit.Faker{Rand: r}).Date().Format(args[0]), nil
+	}
+	params := gofakeit.NewMapParams()
+	for i, arg := range args {
+		if field, value, ok := strings.Cut(arg, "="); ok && getParam(info, field) != nil {
+			params.Add(field, value)
+			continue
+		}
+		switch {
+		case i < len(info.Params):
+			params.Add(info.Params[i].Field, arg)
+		case len(info.Params) > 0 && strings.HasPrefix(info.Params[len(info.Params)-1].Type, "[]"):
+			// the rest of arguments are appended to the last parameter if it is an array
+			params.Add(info.Params[len(info.Params)-1].Field, arg)
+		default:
+			return "", fmt.Errorf("too many arguments of faker function: %s", name)
+		}
+	}
+	value, err := info.Generate(r, params, info)
+	if err != nil {
+		return "", err
+	}
+	switch val := value.(type) {
+	case string:
+		return val, nil
+	case []byte:
+		return string(val), nil
+	case time.Time:
+		return val.Format(time.RFC3339), nil
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Struct, reflect.Ptr, reflect.Map, reflect.Slice, reflect.Array:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
+
+func getParam(info *gofakeit.Info, field string) *gofakeit.Param {
+	for i := range info.Params {
+		if info.Params[i].Field == field {
+			return &info.Params[i]
+		}
+	}
+	return nil
+}
+
+func hasOption(param gofakeit.Param, option string) bool {
+	for _, item := range param.Options {
+		if item == option {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseFakerCall is used to parse the name and arguments of faker call, such as number(1, 100),
+// arguments can be quoted by single or double quotes
+func ParseFakerCall(call string) (string, []string) {
+	call = strings.TrimSpace(call)
+	i := strings.Index(call, "(")
+	if i < 0 || !strings.HasSuffix(call, ")") {
+		return call, nil
+	}
+	name, raw := strings.TrimSpace(call[:i]), call[i+1:len(call)-1]
+	var args []string
+	var current strings.Builder
+	var quote rune
+	var quoted bool
+	// end is the length of current without the trailing spaces which are not quoted
+	var end int
+	for _, c := range raw {
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(c)
+			end = current.Len()
+		case c == '"' || c == '\'':
+			quote, quoted = c, true
+		case c == ',':
+			args = append(args, current.String()[:end])
+			current.Reset()
+			quoted, end = false, 0
+		case c == ' ':
+			if current.Len() > 0 {
+				current.WriteRune(c)
+			}
+		default:
+			current.WriteRune(c)
+			end = current.Len()
+		}
+	}
+	if last := current.String()[:end]; last != "" || quoted || len(args) > 0 {
+		args = append(args, last)
+	}
+	return name, args
+}
+
+// SetSeed is used to make values of faker deterministic,
+// the same seed always generates the same sequence of values
+func (c *Context) SetSeed(seed string) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	c.rand = rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// Rand returns the random source of faker, it is randomly seeded unless SetSeed is called
+func (c *Context) Rand() *rand.Rand {
+	if c.rand == nil {
+		c.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return c.rand
+}
+
+// LoadFakerLocale is used to replace the data of faker with the locale file <dir>/<locale>.json,
+// the file is in the format of {"person": {"first": ["..."], "last": ["..."]}, "address": {"city": ["..."]}},
+// data which is not contained in the file is kept as English
+// It affects the whole process and should be called at startup
+func LoadFakerLocale(locale string, dir string) error {
+	if locale == "" || locale == "en" {
+		return nil
+	}
+	if dir == "" {
+		return fmt.Errorf("faker locale dir is required for locale: %s", locale)
+	}
+	raw, err := os.ReadFile(filepath.Join(dir, locale+".json"))
+	if err != nil {
+		return err
+	}
+	var localeData map[string]map[string][]string
+	if err := json.Unmarshal(raw, &localeData); err != nil {
+		return fmt.Errorf("failed to parse faker locale %s: %s", locale, err)
+	}
+	for key, values := range localeData {
+		for subKey, items := range values {
+			if len(items) == 0 {
+				continue
+			}
+			data.SetSub(key, subKey, items)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6/data"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+func TestParseFakerCall(t *testing.T) {
+	tests := []struct {
+		call       string
+		expectName string
+		expectArgs []string
+	}{
+		{call: "name", expectName: "name"},
+		{call: " name ", expectName: "name"},
+		{call: "name()", expectName: "name"},
+		{call: "number(1, 100)", expectName: "number", expectArgs: []string{"1", "100"}},
+		{call: "number(min=1,max=100)", expectName: "number", expectArgs: []string{"min=1", "max=100"}},
+		{call: `randomstring("a, b", 'c', d)`, expectName: "randomstring", expectArgs: []string{"a, b", "c", "d"}},
+		{call: `randomstring(" a ", '')`, expectName: "randomstring", expectArgs: []string{" a ", ""}},
+		{call: "number( 1 , 2 )", expectName: "number", expectArgs: []string{"1", "2"}},
+		{call: "number(1, )", expectName: "number", expectArgs: []string{"1", ""}},
+		{call: "number(1", expectName: "number(1"},
+	}
+	for _, test := range tests {
+		name, args := ParseFakerCall(test.call)
+		assert.Equal(t, test.expectName, name, test.call)
+		assert.Equal(t, test.expectArgs, args, test.call)
+	}
+}
+
+func TestFake(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		expect    string
+		expectErr bool
+	}{
+		{name: "name", expect: `^\S+ \S+`},
+		{name: "Email", expect: `^\S+@\S+$`},
+		{name: "number", args: []string{"1", "3"}, expect: `^[1-3]$`},
+		{name: "number", args: []string{"max=3", "min=3"}, expect: `^3$`},
+		{name: "price", expect: `^\d+(\.\d+)?$`},
+		{name: "bool", expect: `^(true|false)$`},
+		{name: "randomstring", args: []string{"a", "b", "c"}, expect: `^[abc]$`},
+		{name: "date", expect: `^\d{4}-\d{2}-\d{2}T`},
+		{name: "date", args: []string{"RFC1123"}, expect: `^\w{3}, \d{2} \w{3} \d{4}`},
+		{name: "date", args: []string{"2006/01/02"}, expect: `^\d{4}/\d{2}/\d{2}$`},
+		{name: "creditcard", expect: `^\{"type":`},
+		{name: "number", args: []string{"1", "2", "3"}, expectErr: true},
+		{name: "number", args: []string{"a"}, expectErr: true},
+		{name: "unknown", expectErr: true},
+	}
+	ctx := NewContext(&interact.Request{})
+	for _, test := range tests {
+		value, err := Fake(ctx, test.name, test.args)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Regexp(t, regexp.MustCompile(test.expect), value, test.name)
+	}
+
+	price, err := Fake(ctx, "price", nil)
+	assert.Nil(t, err)
+	value, err := strconv.ParseFloat(price, 64)
+	assert.Nil(t, err)
+	assert.True(t, value >= 0 && value <= 10000, price)
+}
+
+func TestFakeWithSeed(t *testing.T) {
+	fake := func(seed string) []string {
+		ctx := NewContext(&interact.Request{})
+		ctx.SetSeed(seed)
+		var values []string
+		for _, name := range []string{"name", "email", "uuid"} {
+			value, err := Fake(ctx, name, nil)
+			assert.Nil(t, err)
+			values = append(values, value)
+		}
+		return values
+	}
+	assert.Equal(t, fake("1"), fake("1"))
+	assert.NotEqual(t, fake("1"), fake("2"))
+}
+
+func TestRenderWithFaker(t *testing.T) {
+	ctx := NewContext(&interact.Request{})
+	assert.Regexp(t, `^[1-3]$`, Render(ctx, "$mock.number(1, 3)"))
+	assert.Equal(t, "unknown(1)", Render(ctx, "$mock.unknown(1)"))
+	assert.Equal(t, "number(a)", Render(ctx, "$mock.number(a)"))
+}
+
+func TestLoadFakerLocale(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "test.json"), []byte(`{"person": {"first": ["Lei"], "last": []}}`), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`{"person": ["Lei"]}`), 0644))
+	first, last := data.GetSubData("person", "first"), data.GetSubData("person", "last")
+	t.Cleanup(func() {
+		data.SetSub("person", "first", first)
+		data.SetSub("person", "last", last)
+	})
+
+	tests := []struct {
+		locale    string
+		dir       string
+		expectErr bool
+	}{
+		{locale: ""},
+		{locale: "en"},
+		{locale: "test", expectErr: true},
+		{locale: "unknown", dir: dir, expectErr: true},
+		{locale: "invalid", dir: dir, expectErr: true},
+		{locale: "test", dir: dir},
+	}
+	for _, test := range tests {
+		err := LoadFakerLocale(test.locale, test.dir)
+		assert.Equal(t, test.expectErr, err != nil, test.locale)
+	}
+	assert.Equal(t, []string{"Lei"}, data.GetSubData("person", "first"))
+	// data which is not contained in the file is kept
+	assert.Equal(t, last, data.GetSubData("person", "last"))
+	value, err := Fake(NewContext(&interact.Request{}), "firstname", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Lei", value)
+}
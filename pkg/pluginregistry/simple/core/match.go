@@ -15,12 +15,52 @@
 package core
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/mod/semver"
+)
+
+// defines the operators which check the existence of operandX,
+// they are evaluated by Exists with the path of operandX instead of Match
+const (
+	OperatorExists    = "exists"
+	OperatorNotExists = "notExists"
+)
+
+// operators defines all supported operators of Match
+var operators = map[string]bool{
+	"=": true, "==": true, "===": true, "!=": true,
+	">": true, ">=": true, "<": true, "<=": true,
+	"regex": true, "in": true,
+	OperatorExists: true, OperatorNotExists: true,
+	"contains": true, "startsWith": true, "prefix": true, "endsWith": true, "suffix": true,
+	"equalsIgnoreCase": true, "oneOf": true,
+	"semver==": true, "semver>": true, "semver>=": true, "semver<": true, "semver<=": true,
+	"before": true, "after": true,
+	"cidr":       true,
+	"jsonSchema": true,
+	"jsonPath":   true,
+}
+
+// jsonPathLanguage supports JSONPath with filter expressions, such as $.items[?(@.price > 10)]
+var jsonPathLanguage = gval.Full(jsonpath.Language())
+
+var (
+	// schemas caches the compiled JSON schemas by their text
+	schemas cache[*jsonschema.Schema]
+	// jsonPaths caches the parsed JSONPath expressions by their text
+	jsonPaths cache[gval.Evaluable]
 )
 
 // Float64 is used to convert a string to float64
@@ -56,7 +96,218 @@ func Match(operandX string, operator string, operandY string) (bool, error) {
 		return expr.MatchString(operandX), nil
 	case "in":
 		return strings.Contains(operandY, operandX), nil
+	case OperatorExists:
+		return operandX != "", nil
+	case OperatorNotExists:
+		return operandX == "", nil
+	case "contains":
+		return strings.Contains(operandX, operandY), nil
+	case "startsWith", "prefix":
+		return strings.HasPrefix(operandX, operandY), nil
+	case "endsWith", "suffix":
+		return strings.HasSuffix(operandX, operandY), nil
+	case "equalsIgnoreCase":
+		return strings.EqualFold(operandX, operandY), nil
+	case "oneOf":
+		for _, item := range parseList(operandY) {
+			if item == operandX {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "semver==", "semver>", "semver>=", "semver<", "semver<=":
+		x, y := canonicalSemver(operandX), canonicalSemver(operandY)
+		if x == "" || y == "" {
+			return false, nil
+		}
+		return compareResult(strings.TrimPrefix(operator, "semver"), semver.Compare(x, y)), nil
+	case "before", "after":
+		x, err := parseTime(operandX)
+		if err != nil {
+			return false, nil
+		}
+		y, err := parseTime(operandY)
+		if err != nil {
+			return false, err
+		}
+		if operator == "before" {
+			return x.Before(y), nil
+		}
+		return x.After(y), nil
+	case "cidr":
+		ip := net.ParseIP(operandX)
+		if ip == nil {
+			return false, nil
+		}
+		for _, item := range parseList(operandY) {
+			_, network, err := net.ParseCIDR(item)
+			if err != nil {
+				return false, err
+			}
+			if network.Contains(ip) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "jsonSchema":
+		schema, err := compileSchema(operandY)
+		if err != nil {
+			return false, err
+		}
+		return schema.Validate(parseJSON(operandX)) == nil, nil
+	case "jsonPath":
+		eval, err := compileJSONPath(operandY)
+		if err != nil {
+			return false, err
+		}
+		result, err := eval(context.Background(), parseJSON(operandX))
+		if err != nil {
+			return false, nil
+		}
+		return !isEmpty(result), nil
 	default:
 		return false, fmt.Errorf("unknown operator: %s", operator)
 	}
 }
+
+// ValidateOperator is used to check whether operator is supported,
+// operandY is validated as well if it is static (not a variable),
+// such as the pattern of regex and the schema of jsonSchema
+func ValidateOperator(operator string, operandY string) error {
+	if !operators[operator] {
+		return fmt.Errorf("unknown operator: %s", operator)
+	}
+	// operandY of jsonPath is always an expression which starts with $
+	if strings.HasPrefix(operandY, "$") && operator != "jsonPath" {
+		return nil
+	}
+	var err error
+	switch operator {
+	case "regex":
+		_, err = regexp.Compile(operandY)
+	case "semver==", "semver>", "semver>=", "semver<", "semver<=":
+		if canonicalSemver(operandY) == "" {
+			err = fmt.Errorf("invalid semantic version: %s", operandY)
+		}
+	case "before", "after":
+		_, err = parseTime(operandY)
+	case "cidr":
+		for _, item := range parseList(operandY) {
+			if _, _, err = net.ParseCIDR(item); err != nil {
+				break
+			}
+		}
+	case "jsonSchema":
+		_, err = compileSchema(operandY)
+	case "jsonPath":
+		_, err = compileJSONPath(operandY)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid operandY of %s: %s", operator, err)
+	}
+	return nil
+}
+
+// parseList is used to parse a JSON array or comma separated values
+func parseList(s string) []string {
+	var items []interface{}
+	if err := json.Unmarshal([]byte(s), &items); err == nil {
+		result := make([]string, 0, len(items))
+		for _, item := range items {
+			result = append(result, fmt.Sprint(item))
+		}
+		return result
+	}
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		result = append(result, strings.TrimSpace(item))
+	}
+	return result
+}
+
+// canonicalSemver returns the canonical form of version with the v prefix, or empty if it is invalid
+func canonicalSemver(version string) string {
+	version = strings.TrimSpace(version)
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return semver.Canonical(version)
+}
+
+func compareResult(operator string, result int) bool {
+	switch operator {
+	case "==":
+		return result == 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	default:
+		return result <= 0
+	}
+}
+
+// parseTime is used to parse time in RFC3339, date (2006-01-02), unix seconds or milliseconds,
+// and now with an optional offset, such as now-1h
+func parseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "now") {
+		offset := strings.TrimPrefix(s, "now")
+		if offset == "" {
+			return time.Now(), nil
+		}
+		d, err := time.ParseDuration(strings.TrimPrefix(offset, "+"))
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Now().Add(d), nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		// timestamps greater than 1e11 are treated as milliseconds
+		if n > 1e11 {
+			return time.Unix(0, n*int64(time.Millisecond)), nil
+		}
+		return time.Unix(n, 0), nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %s", s)
+}
+
+func compileSchema(schema string) (*jsonschema.Schema, error) {
+	return schemas.get(schema, func(schema string) (*jsonschema.Schema, error) {
+		return jsonschema.CompileString("schema.json", schema)
+	})
+}
+
+func compileJSONPath(path string) (gval.Evaluable, error) {
+	return jsonPaths.get(path, jsonPathLanguage.NewEvaluable)
+}
+
+// parseJSON is used to decode the rendered operand, it is treated as a string if it is not valid JSON,
+// because the string values are rendered without quotes
+func parseJSON(s string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return s
+	}
+	return v
+}
+
+func isEmpty(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(val) == 0
+	case map[string]interface{}:
+		return len(val) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+func TestMatch(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		operandX  string
+		operator  string
+		operandY  string
+		expect    bool
+		expectErr bool
+	}{
+		{operandX: "a", operator: "=", operandY: "a", expect: true},
+		{operandX: "a", operator: "==", operandY: "b", expect: false},
+		{operandX: "a", operator: "===", operandY: "a", expect: true},
+		{operandX: "a", operator: "!=", operandY: "b", expect: true},
+		{operandX: "10", operator: ">", operandY: "9", expect: true},
+		{operandX: "10", operator: ">=", operandY: "10", expect: true},
+		{operandX: "9.5", operator: "<", operandY: "10", expect: true},
+		{operandX: "a", operator: "<=", operandY: "0", expect: true},
+		{operandX: "abc", operator: "regex", operandY: "^a.c$", expect: true},
+		{operandX: "abc", operator: "regex", operandY: "(", expect: false},
+		{operandX: "b", operator: "in", operandY: "abc", expect: true},
+		{operandX: "a", operator: "exists", expect: true},
+		{operandX: "", operator: "notExists", expect: true},
+		{operandX: "abc", operator: "contains", operandY: "b", expect: true},
+		{operandX: "abc", operator: "startsWith", operandY: "ab", expect: true},
+		{operandX: "abc", operator: "prefix", operandY: "b", expect: false},
+		{operandX: "abc", operator: "endsWith", operandY: "bc", expect: true},
+		{operandX: "abc", operator: "suffix", operandY: "b", expect: false},
+		{operandX: "ABC", operator: "equalsIgnoreCase", operandY: "abc", expect: true},
+		{operandX: "b", operator: "oneOf", operandY: "a, b, c", expect: true},
+		{operandX: "2", operator: "oneOf", operandY: `[1, 2]`, expect: true},
+		{operandX: "d", operator: "oneOf", operandY: `["a", "b"]`, expect: false},
+		{operandX: "1.2.3", operator: "semver==", operandY: "v1.2.3", expect: true},
+		{operandX: "1.10.0", operator: "semver>", operandY: "1.9.0", expect: true},
+		{operandX: "1.2", operator: "semver>=", operandY: "1.2.0", expect: true},
+		{operandX: "1.2.3-beta", operator: "semver<", operandY: "1.2.3", expect: true},
+		{operandX: "2.0.0", operator: "semver<=", operandY: "1.0.0", expect: false},
+		{operandX: "invalid", operator: "semver==", operandY: "1.0.0", expect: false},
+		{operandX: "2021-01-01", operator: "before", operandY: "2021-01-02", expect: true},
+		{operandX: "2021-01-01T00:00:00Z", operator: "after", operandY: "1609372800", expect: true},
+		{operandX: fmt.Sprint(now.Add(-time.Hour).UnixNano() / int64(time.Millisecond)), operator: "before", operandY: "now-30m", expect: true},
+		{operandX: now.Add(time.Hour).Format(time.RFC3339), operator: "after", operandY: "now+30m", expect: true},
+		{operandX: "2021-01-01 08:00:00", operator: "before", operandY: "now", expect: true},
+		{operandX: "invalid", operator: "before", operandY: "now", expect: false},
+		{operandX: "now", operator: "before", operandY: "invalid", expectErr: true},
+		{operandX: "10.0.0.1", operator: "cidr", operandY: "10.0.0.0/8", expect: true},
+		{operandX: "192.168.1.1", operator: "cidr", operandY: "10.0.0.0/8, 192.168.0.0/16", expect: true},
+		{operandX: "::1", operator: "cidr", operandY: `["10.0.0.0/8"]`, expect: false},
+		{operandX: "invalid", operator: "cidr", operandY: "10.0.0.0/8", expect: false},
+		{operandX: "10.0.0.1", operator: "cidr", operandY: "invalid", expectErr: true},
+		{operandX: `{"age": 3}`, operator: "jsonSchema", operandY: `{"type": "object", "required": ["age"]}`, expect: true},
+		{operandX: `{}`, operator: "jsonSchema", operandY: `{"type": "object", "required": ["age"]}`, expect: false},
+		{operandX: "text", operator: "jsonSchema", operandY: `{"type": "string"}`, expect: true},
+		{operandX: "{}", operator: "jsonSchema", operandY: `{"type": 1}`, expectErr: true},
+		{operandX: `{"items": [{"price": 20}]}`, operator: "jsonPath", operandY: `$.items[?(@.price > 10)]`, expect: true},
+		{operandX: `{"items": [{"price": 5}]}`, operator: "jsonPath", operandY: `$.items[?(@.price > 10)]`, expect: false},
+		{operandX: `{"name": "a"}`, operator: "jsonPath", operandY: `$.age`, expect: false},
+		{operandX: `{}`, operator: "jsonPath", operandY: `$.[`, expectErr: true},
+		{operandX: "a", operator: "unknown", operandY: "a", expectErr: true},
+	}
+	for _, test := range tests {
+		name := fmt.Sprintf("%s %s %s", test.operandX, test.operator, test.operandY)
+		matched, err := Match(test.operandX, test.operator, test.operandY)
+		if test.expectErr {
+			assert.NotNil(t, err, name)
+			continue
+		}
+		assert.Nil(t, err, name)
+		assert.Equal(t, test.expect, matched, name)
+	}
+}
+
+func TestMatchCache(t *testing.T) {
+	for i := 0; i < 2*maxCacheSize; i++ {
+		matched, err := Match(fmt.Sprintf(`{"id": %d}`, i), "jsonSchema", fmt.Sprintf(`{"properties": {"id": {"const": %d}}}`, i))
+		assert.Nil(t, err)
+		assert.True(t, matched)
+		matched, err = Match(`[{"id": 1}]`, "jsonPath", fmt.Sprintf(`$[?(@.id == %d)]`, i))
+		assert.Nil(t, err)
+		assert.Equal(t, i == 1, matched, i)
+	}
+	assert.LessOrEqual(t, schemas.len(), maxCacheSize)
+	assert.LessOrEqual(t, jsonPaths.len(), maxCacheSize)
+}
+
+func TestValidateOperator(t *testing.T) {
+	tests := []struct {
+		operator  string
+		operandY  string
+		expectErr bool
+	}{
+		{operator: "=="},
+		{operator: "like", expectErr: true},
+		{operator: "regex", operandY: "^a"},
+		{operator: "regex", operandY: "(", expectErr: true},
+		{operator: "regex", operandY: "$request.query.pattern"},
+		{operator: "semver>", operandY: "1.2.3"},
+		{operator: "semver>", operandY: "latest", expectErr: true},
+		{operator: "before", operandY: "now-1h"},
+		{operator: "after", operandY: "yesterday", expectErr: true},
+		{operator: "cidr", operandY: "10.0.0.0/8, 192.168.0.0/16"},
+		{operator: "cidr", operandY: "10.0.0.0/8, invalid", expectErr: true},
+		{operator: "jsonSchema", operandY: `{"type": "object"}`},
+		{operator: "jsonSchema", operandY: `{"type": 1}`, expectErr: true},
+		{operator: "jsonPath", operandY: `$.items[0]`},
+		{operator: "jsonPath", operandY: `$.[`, expectErr: true},
+	}
+	for _, test := range tests {
+		err := ValidateOperator(test.operator, test.operandY)
+		assert.Equal(t, test.expectErr, err != nil, "%s %s: %v", test.operator, test.operandY, err)
+	}
+}
+
+func TestExists(t *testing.T) {
+	ctx := NewContext(&interact.Request{
+		Header: interact.Values{"uid": []string{"1"}, "empty": []string{""}},
+		Body:   interact.NewBytesMessage([]byte(`{"name": "powermock", "tags": []}`)),
+	})
+	tests := []struct {
+		path   string
+		expect bool
+	}{
+		{path: "$request.header.uid", expect: true},
+		{path: "$request.header.empty", expect: true},
+		{path: "$request.header.unknown", expect: false},
+		{path: "$request.body.name", expect: true},
+		{path: "$request.body.tags", expect: true},
+		{path: "$request.body.age", expect: false},
+		{path: "$mock.name", expect: true},
+		{path: "constant", expect: true},
+		{path: "", expect: false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expect, Exists(ctx, test.path), test.path)
+	}
+}
@@ -15,10 +15,10 @@
 package core
 
 import (
-	"fmt"
+	"math/rand"
 	"strings"
+	"sync"
 
-	"github.com/brianvoe/gofakeit"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/tidwall/gjson"
 
@@ -28,6 +28,13 @@ import (
 // Context defines the context
 type Context struct {
 	Request *interact.Request `json:"request"`
+
+	// rand is the random source of faker
+	rand *rand.Rand
+	// data is the data of templates, it is lazily computed from Request
+	data     map[string]interface{}
+	dataErr  error
+	dataOnce sync.Once
 }
 
 // NewContext is used to create context
@@ -46,22 +53,29 @@ func RenderWithRequest(ctx *Context, path string) string {
 	return gjson.GetBytes(data, path).String()
 }
 
-// RenderWithRequest is used to render $mock... variable
+// Exists is used to check whether the variable of path exists, such as $request.header.uid,
+// other values exist if they are not empty after rendering
+func Exists(ctx *Context, path string) bool {
+	scope, subPath := SplitWithFirstSegment(path, ".")
+	if scope != "$request" {
+		return Render(ctx, path) != ""
+	}
+	data, err := jsoniter.Marshal(ctx.Request)
+	if err != nil {
+		return false
+	}
+	return gjson.GetBytes(data, subPath).Exists()
+}
+
+// RenderWithFaker is used to render $mock... variable, such as $mock.name and $mock.number(1, 100),
+// the path is returned as is if the faker function is unknown or the arguments are invalid
 func RenderWithFaker(ctx *Context, path string) string {
-	switch path {
-	case "name":
-		return gofakeit.Name()
-	case "url":
-		return gofakeit.URL()
-	case "lastname":
-		return gofakeit.LastName()
-	case "email":
-		return gofakeit.Email()
-	case "price":
-		data := fmt.Sprint(gofakeit.Price(0, 10000))
-		return data
+	name, args := ParseFakerCall(path)
+	value, err := Fake(ctx, name, args)
+	if err != nil {
+		return path
 	}
-	return path
+	return value
 }
 
 // SplitWithFirstSegment is used to extract the first segment of s divided by split
@@ -85,7 +99,3 @@ func Render(ctx *Context, path string) string {
 		return path
 	}
 }
-
-func init() {
-	gofakeit.Seed(0)
-}
@@ -0,0 +1,311 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// templates caches the parsed templates by their text
+var templates cache[*template.Template]
+
+// ExecuteTemplate is used to render text by the text/template of Go with helper functions,
+// the data of template is {"request": <the JSON format of request>}, such as {{ .request.body.name }}
+func ExecuteTemplate(ctx *Context, text string) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	data, err := ctx.templateData()
+	if err != nil {
+		return "", err
+	}
+	// random functions are bound to the context so that they use the random source of context
+	tmpl, err = tmpl.Clone()
+	if err != nil {
+		return "", err
+	}
+	tmpl.Funcs(ctx.randomFuncs())
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	return templates.get(text, func(text string) (*template.Template, error) {
+		return template.New("").Option("missingkey=zero").Funcs(templateFuncs).Parse(text)
+	})
+}
+
+// templateData is used to convert the request to generic values so that it can be accessed by templates,
+// the result is computed only once for each context
+func (c *Context) templateData() (map[string]interface{}, error) {
+	c.dataOnce.Do(func() {
+		var raw []byte
+		raw, c.dataErr = jsoniter.Marshal(c.Request)
+		if c.dataErr != nil {
+			return
+		}
+		var request interface{}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		if c.dataErr = decoder.Decode(&request); c.dataErr != nil {
+			return
+		}
+		c.data = map[string]interface{}{
+			"request": request,
+		}
+	})
+	return c.data, c.dataErr
+}
+
+// templateFuncs defines the helper functions of templates,
+// the value being processed is always the last argument so that functions can be used in pipelines,
+// such as {{ now | dateAdd "24h" | format "2006-01-02" }}
+var templateFuncs = template.FuncMap{
+	// random functions are replaced by the ones bound to context while executing
+	"mock":       func(name string, args ...interface{}) (string, error) { return "", nil },
+	"uuid":       func() string { return "" },
+	"randInt":    func(min, max interface{}) int64 { return 0 },
+	"randFloat":  func(min, max interface{}) float64 { return 0 },
+	"randString": func(length interface{}) string { return "" },
+	"randChoice": func(values ...interface{}) interface{} { return nil },
+
+	// time
+	"now":       time.Now,
+	"format":    func(layout string, t time.Time) string { return t.Format(layout) },
+	"parseTime": time.Parse,
+	"unix":      func(t time.Time) int64 { return t.Unix() },
+	"unixMilli": func(t time.Time) int64 { return t.UnixNano() / int64(time.Millisecond) },
+	"fromUnix":  func(sec interface{}) time.Time { return time.Unix(toInt64(sec), 0) },
+	"dateAdd": func(duration string, t time.Time) (time.Time, error) {
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			return t, err
+		}
+		return t.Add(d), nil
+	},
+	"dateAddDate": func(years, months, days int, t time.Time) time.Time { return t.AddDate(years, months, days) },
+
+	// encoding
+	"base64Encode": func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+	"base64Decode": func(s string) (string, error) {
+		data, err := base64.StdEncoding.DecodeString(s)
+		return string(data), err
+	},
+	"urlEncode":  url.QueryEscape,
+	"urlDecode":  url.QueryUnescape,
+	"pathEscape": url.PathEscape,
+	"toJSON": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"fromJSON": func(s string) (interface{}, error) {
+		var v interface{}
+		decoder := json.NewDecoder(strings.NewReader(s))
+		decoder.UseNumber()
+		err := decoder.Decode(&v)
+		return v, err
+	},
+
+	// hashing
+	"md5":    func(s string) string { return hashString(md5.New(), s) },
+	"sha1":   func(s string) string { return hashString(sha1.New(), s) },
+	"sha256": func(s string) string { return hashString(sha256.New(), s) },
+	"hmacSha256": func(key string, s string) string {
+		return hashString(hmac.New(sha256.New, []byte(key)), s)
+	},
+
+	// strings
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"title":      strings.Title,
+	"trim":       strings.TrimSpace,
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+	"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+	"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+	"split":      func(sep, s string) []string { return strings.Split(s, sep) },
+	"join": func(sep string, values interface{}) string {
+		var items []string
+		switch v := values.(type) {
+		case []string:
+			items = v
+		case []interface{}:
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+		default:
+			return fmt.Sprint(values)
+		}
+		return strings.Join(items, sep)
+	},
+	"repeat": func(count int, s string) string { return strings.Repeat(s, count) },
+	"substr": func(start, end int, s string) string {
+		runes := []rune(s)
+		if start < 0 {
+			start = 0
+		}
+		if end < 0 || end > len(runes) {
+			end = len(runes)
+		}
+		if start > end {
+			return ""
+		}
+		return string(runes[start:end])
+	},
+	"default": func(def, v interface{}) interface{} {
+		if v == nil || fmt.Sprint(v) == "" {
+			return def
+		}
+		return v
+	},
+	"toString": func(v interface{}) string { return fmt.Sprint(v) },
+	"toInt":    toInt64,
+	"toFloat":  toFloat64,
+
+	// math
+	"add": func(a, b interface{}) float64 { return toFloat64(a) + toFloat64(b) },
+	"sub": func(a, b interface{}) float64 { return toFloat64(a) - toFloat64(b) },
+	"mul": func(a, b interface{}) float64 { return toFloat64(a) * toFloat64(b) },
+	"div": func(a, b interface{}) float64 { return toFloat64(a) / toFloat64(b) },
+	"mod": func(a, b interface{}) int64 {
+		if toInt64(b) == 0 {
+			return 0
+		}
+		return toInt64(a) % toInt64(b)
+	},
+	"seq": func(count interface{}) []int {
+		n := toInt64(count)
+		if n < 0 {
+			n = 0
+		}
+		result := make([]int, n)
+		for i := range result {
+			result[i] = i
+		}
+		return result
+	},
+}
+
+// randomFuncs returns the random functions of templates which use the random source of context,
+// so that they are deterministic if the seed is set
+func (c *Context) randomFuncs() template.FuncMap {
+	r := c.Rand()
+	return template.FuncMap{
+		"mock": func(name string, args ...interface{}) (string, error) {
+			var fakerArgs []string
+			for _, arg := range args {
+				fakerArgs = append(fakerArgs, fmt.Sprint(arg))
+			}
+			return Fake(c, name, fakerArgs)
+		},
+		"uuid": func() string {
+			// random (version 4) UUID
+			var b [16]byte
+			_, _ = r.Read(b[:])
+			b[6] = (b[6] & 0x0f) | 0x40
+			b[8] = (b[8] & 0x3f) | 0x80
+			return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+		},
+		"randInt": func(min, max interface{}) int64 {
+			lo, hi := toInt64(min), toInt64(max)
+			if hi <= lo {
+				return lo
+			}
+			return lo + r.Int63n(hi-lo+1)
+		},
+		"randFloat": func(min, max interface{}) float64 {
+			lo, hi := toFloat64(min), toFloat64(max)
+			return lo + r.Float64()*(hi-lo)
+		},
+		"randString": func(length interface{}) string {
+			const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+			n := toInt64(length)
+			if n <= 0 {
+				return ""
+			}
+			result := make([]byte, n)
+			for i := range result {
+				result[i] = letters[r.Intn(len(letters))]
+			}
+			return string(result)
+		},
+		"randChoice": func(values ...interface{}) interface{} {
+			if len(values) == 0 {
+				return nil
+			}
+			return values[r.Intn(len(values))]
+		},
+	}
+}
+
+func hashString(h hash.Hash, s string) string {
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func toFloat64(v interface{}) float64 {
+	switch val := v.(type) {
+	case float64:
+		return val
+	case int:
+		return float64(val)
+	case int64:
+		return float64(val)
+	default:
+		return Float64(fmt.Sprint(v))
+	}
+}
+
+func toInt64(v interface{}) int64 {
+	switch val := v.(type) {
+	case int:
+		return int64(val)
+	case int64:
+		return val
+	case float64:
+		return int64(val)
+	default:
+		s := fmt.Sprint(v)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+		return int64(Float64(s))
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/interact"
+)
+
+func TestExecuteTemplate(t *testing.T) {
+	ctx := NewContext(&interact.Request{
+		Protocol: interact.ProtocolHTTP,
+		Method:   "POST",
+		Path:     "/users/1",
+		Header:   interact.Values{"x-user": []string{"powermock"}},
+		Query:    map[string][]string{"page": {"2"}},
+		Params:   map[string]string{"id": "1"},
+		Body:     interact.NewBytesMessage([]byte(`{"name": "powermock", "age": 3, "tags": ["a", "b"]}`)),
+	})
+	tests := []struct {
+		text      string
+		expect    string
+		expectErr bool
+	}{
+		{text: "hello", expect: "hello"},
+		{text: "{{ .request.method }} {{ .request.path }}", expect: "POST /users/1"},
+		{text: "{{ .request.body.name }} is {{ .request.body.age }}", expect: "powermock is 3"},
+		{text: `{{ index .request.header "x-user" }}`, expect: "powermock"},
+		{text: "{{ .request.query.page }}", expect: "2"},
+		{text: "{{ .request.params.id }}", expect: "1"},
+		{text: "[{{ .request.body.unknown }}]", expect: "[<no value>]"},
+		{text: `{{ join "," .request.body.tags }}`, expect: "a,b"},
+		{text: `{{ range $i, $tag := .request.body.tags }}{{ $i }}{{ $tag }}{{ end }}`, expect: "0a1b"},
+		{text: `{{ add .request.body.age 1 }}`, expect: "4"},
+		{text: `{{ sub 3 1 }} {{ mul 2 3 }} {{ div 7 2 }} {{ mod 7 2 }} {{ mod 7 0 }}`, expect: "2 6 3.5 1 0"},
+		{text: `{{ range seq 3 }}{{ . }}{{ end }}`, expect: "012"},
+		{text: `{{ toInt "12" }} {{ toFloat "1.5" }} {{ toString 1 }}`, expect: "12 1.5 1"},
+		{text: `{{ parseTime "2006-01-02" "2021-03-04" | format "02/01/2006" }}`, expect: "04/03/2021"},
+		{text: `{{ fromUnix 0 | dateAddDate 0 0 1 | unix }}`, expect: "86400"},
+		{text: `{{ fromUnix 0 | dateAdd "24h" | unix }}`, expect: "86400"},
+		{text: `{{ fromUnix 1 | unixMilli }}`, expect: "1000"},
+		{text: `{{ fromUnix 0 | dateAdd "1x" }}`, expectErr: true},
+		{text: `{{ (parseTime "2006-01-02" "2021-03-04").Year }}`, expect: "2021"},
+		{text: `{{ "hello" | base64Encode }} {{ "aGVsbG8=" | base64Decode }}`, expect: "aGVsbG8= hello"},
+		{text: `{{ "a b&c" | urlEncode }} {{ "a+b" | urlDecode }} {{ "a b" | pathEscape }}`, expect: "a+b%26c a b a%20b"},
+		{text: `{{ toJSON .request.body.tags }}`, expect: `["a","b"]`},
+		{text: `{{ (fromJSON "{\"a\": 1}").a }}`, expect: "1"},
+		{text: `{{ md5 "a" }}`, expect: "0cc175b9c0f1b6a831c399e269772661"},
+		{text: `{{ sha1 "a" }}`, expect: "86f7e437faa5a7fce15d1ddcb9eaeaea377667b8"},
+		{text: `{{ sha256 "a" }}`, expect: "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"},
+		{text: `{{ hmacSha256 "key" "a" }}`, expect: "780c3db4ce3de5b9e55816fba98f590631d96c075271b26976238d5f4444219b"},
+		{text: `{{ upper "a" }}{{ lower "B" }}{{ title "c" }}[{{ trim " d " }}]`, expect: "AbC[d]"},
+		{text: `{{ "abc" | trimPrefix "a" | trimSuffix "c" }}`, expect: "b"},
+		{text: `{{ "a-b" | replace "-" "+" }} {{ "abc" | contains "b" }} {{ "abc" | hasPrefix "a" }} {{ "abc" | hasSuffix "a" }}`, expect: "a+b true true false"},
+		{text: `{{ "a,b" | split "," | join "-" }} {{ "ab" | repeat 2 }}`, expect: "a-b abab"},
+		{text: `{{ "hello" | substr 1 3 }} {{ "hello" | substr -1 10 }} [{{ "hello" | substr 3 1 }}]`, expect: "el hello []"},
+		{text: `{{ .request.body.unknown | default "none" }} {{ .request.body.name | default "none" }}`, expect: "none powermock"},
+		{text: "{{ .request.method", expectErr: true},
+		{text: "{{ unknown }}", expectErr: true},
+	}
+	for _, test := range tests {
+		result, err := ExecuteTemplate(ctx, test.text)
+		if test.expectErr {
+			assert.NotNil(t, err, test.text)
+			continue
+		}
+		assert.Nil(t, err, test.text)
+		assert.Equal(t, test.expect, result, test.text)
+	}
+}
+
+func TestExecuteTemplateWithSeed(t *testing.T) {
+	text := `{{ uuid }} {{ randInt 1 100 }} {{ randFloat 1 2 }} {{ randString 8 }} {{ randChoice "a" "b" "c" }}`
+	execute := func(seed string) string {
+		ctx := NewContext(&interact.Request{})
+		ctx.SetSeed(seed)
+		result, err := ExecuteTemplate(ctx, text)
+		assert.Nil(t, err)
+		return result
+	}
+	assert.Equal(t, execute("a"), execute("a"))
+	assert.NotEqual(t, execute("a"), execute("b"))
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12} \d+ 1\.\d+ [a-zA-Z0-9]{8} [abc]$`, execute("a"))
+
+	tests := []struct {
+		text   string
+		expect string
+	}{
+		{text: "{{ randInt 5 5 }}", expect: "5"},
+		{text: "{{ randInt 5 1 }}", expect: "5"},
+		{text: "[{{ randString 0 }}]", expect: "[]"},
+		{text: "[{{ randChoice }}]", expect: "[<no value>]"},
+	}
+	for _, test := range tests {
+		result, err := ExecuteTemplate(NewContext(&interact.Request{}), test.text)
+		assert.Nil(t, err, test.text)
+		assert.Equal(t, test.expect, result, test.text)
+	}
+}
+
+func TestParseTemplateCache(t *testing.T) {
+	tmpl, err := parseTemplate("{{ .request.path }}")
+	assert.Nil(t, err)
+	cached, err := parseTemplate("{{ .request.path }}")
+	assert.Nil(t, err)
+	assert.Same(t, tmpl, cached)
+
+	for i := 0; i < 2*maxCacheSize; i++ {
+		_, err := parseTemplate(fmt.Sprintf("{{ .request.path }} %d", i))
+		assert.Nil(t, err)
+	}
+	assert.LessOrEqual(t, templates.len(), maxCacheSize)
+	_, err = parseTemplate("{{ .request.path ")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,290 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simple
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/valyala/fasttemplate"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry/simple/core"
+)
+
+// defines the supported template engines of SimpleResponse
+const (
+	templatePlaceholder = ""
+	templateGo          = "go"
+)
+
+// renderer is used to render the parts of response by the template engine of SimpleResponse
+type renderer struct {
+	c      *core.Context
+	engine string
+}
+
+func newRenderer(c *core.Context, engine string) (*renderer, error) {
+	switch engine {
+	case templatePlaceholder, templateGo:
+		return &renderer{c: c, engine: engine}, nil
+	default:
+		return nil, fmt.Errorf("unknown template engine: %s", engine)
+	}
+}
+
+// value is used to render values of headers, cookies and trailers,
+// the whole value is treated as a path by the default engine, such as $request.header.uid
+func (r *renderer) value(val string) (string, error) {
+	if r.engine == templateGo {
+		return core.ExecuteTemplate(r.c, val)
+	}
+	return core.Render(r.c, val), nil
+}
+
+// seed is used to render the seed and make the random values of context deterministic
+func (r *renderer) seed(seed string) error {
+	rendered, err := r.value(seed)
+	if err != nil {
+		return err
+	}
+	r.c.SetSeed(rendered)
+	return nil
+}
+
+// text is used to render bodies and messages
+func (r *renderer) text(text string) (string, error) {
+	if r.engine == templateGo {
+		return core.ExecuteTemplate(r.c, text)
+	}
+	return renderBody(r.c, text)
+}
+
+// code is used to render the code template and parse it as a number
+func (r *renderer) code(text string) (uint32, error) {
+	rendered, err := r.text(text)
+	if err != nil {
+		return 0, err
+	}
+	code, err := strconv.ParseUint(strings.TrimSpace(rendered), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("rendered code is not a number: %q", rendered)
+	}
+	return uint32(code), nil
+}
+
+// values is used to render headers or trailers, the multiple values are appended to the single ones
+func (r *renderer) values(single map[string]string, multiple map[string]*v1alpha1.MockAPI_Response_Values) (interact.Values, error) {
+	result := interact.Values{}
+	for _, key := range sortedKeys(single) {
+		rendered, err := r.value(single[key])
+		if err != nil {
+			return nil, err
+		}
+		result.Set(key, rendered)
+	}
+	for _, key := range sortedKeys(multiple) {
+		for _, val := range multiple[key].GetValues() {
+			rendered, err := r.value(val)
+			if err != nil {
+				return nil, err
+			}
+			result.Add(key, rendered)
+		}
+	}
+	return result, nil
+}
+
+// cookie is used to render the value of cookie and convert it to the value of set-cookie header
+func (r *renderer) cookie(cookie *v1alpha1.MockAPI_Response_Cookie) (string, error) {
+	value, err := r.value(cookie.GetValue())
+	if err != nil {
+		return "", err
+	}
+	sameSite := http.SameSiteDefaultMode
+	switch strings.ToLower(cookie.GetSameSite()) {
+	case "lax":
+		sameSite = http.SameSiteLaxMode
+	case "strict":
+		sameSite = http.SameSiteStrictMode
+	case "none":
+		sameSite = http.SameSiteNoneMode
+	}
+	return (&http.Cookie{
+		Name:     cookie.GetName(),
+		Value:    value,
+		Path:     cookie.GetPath(),
+		Domain:   cookie.GetDomain(),
+		MaxAge:   int(cookie.GetMaxAge()),
+		Secure:   cookie.GetSecure(),
+		HttpOnly: cookie.GetHttpOnly(),
+		SameSite: sameSite,
+	}).String(), nil
+}
+
+// structured is used to render string leaves of the structured body,
+// a leaf which only contains a typed placeholder is replaced by the typed value with the default engine
+func (r *renderer) structured(value interface{}) (interface{}, error) {
+	switch val := value.(type) {
+	case string:
+		if r.engine == templatePlaceholder {
+			trimmed := strings.TrimSpace(val)
+			if strings.HasPrefix(trimmed, "{{") && strings.HasSuffix(trimmed, "}}") &&
+				strings.Count(trimmed, "{{") == 1 {
+				if path, typ := splitPlaceholder(trimmed[2 : len(trimmed)-2]); typ != "" {
+					return renderTypedValue(r.c, path, typ)
+				}
+			}
+		}
+		return r.text(val)
+	case map[string]interface{}:
+		for _, key := range sortedKeys(val) {
+			rendered, err := r.structured(val[key])
+			if err != nil {
+				return nil, err
+			}
+			val[key] = rendered
+		}
+		return val, nil
+	case []interface{}:
+		for i, item := range val {
+			rendered, err := r.structured(item)
+			if err != nil {
+				return nil, err
+			}
+			val[i] = rendered
+		}
+		return val, nil
+	default:
+		return val, nil
+	}
+}
+
+// sortedKeys is used to render maps in a stable order,
+// so the same seed always draws the same random values for each key
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// status is used to render the message of status and convert details to JSON with their types
+func (r *renderer) status(status *v1alpha1.MockAPI_Response_Status) (*interact.Status, error) {
+	message, err := r.text(status.GetMessage())
+	if err != nil {
+		return nil, err
+	}
+	result := &interact.Status{Message: message}
+	for _, detail := range status.GetDetails() {
+		var typ string
+		var data []byte
+		switch d := detail.GetDetail().(type) {
+		case *v1alpha1.MockAPI_Response_StatusDetail_ErrorInfo_:
+			typ = "google.rpc.ErrorInfo"
+			data, err = protojson.Marshal(d.ErrorInfo)
+		case *v1alpha1.MockAPI_Response_StatusDetail_BadRequest_:
+			typ = "google.rpc.BadRequest"
+			data, err = protojson.Marshal(d.BadRequest)
+		case *v1alpha1.MockAPI_Response_StatusDetail_RetryInfo_:
+			typ = "google.rpc.RetryInfo"
+			data, err = protojson.Marshal(d.RetryInfo)
+		case *v1alpha1.MockAPI_Response_StatusDetail_Message_:
+			typ = d.Message.GetType()
+			if typ == "" {
+				return nil, errors.New("type of status detail is required")
+			}
+			data = []byte(`{}`)
+			if value := d.Message.GetValue(); value != nil {
+				var rendered interface{}
+				rendered, err = r.structured(value.AsInterface())
+				if err == nil {
+					data, err = json.Marshal(rendered)
+				}
+			}
+		default:
+			return nil, errors.New("status detail is required")
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Details = append(result.Details, &interact.StatusDetail{
+			Type: typ,
+			Body: interact.NewBytesMessage(data),
+		})
+	}
+	return result, nil
+}
+
+// renderBody is used to render placeholders in body,
+// the type of placeholder is ignored because the result is always a string
+func renderBody(c *core.Context, body string) (string, error) {
+	return fasttemplate.ExecuteFuncStringWithErr(body, "{{", "}}", func(w io.Writer, tag string) (int, error) {
+		path, _ := splitPlaceholder(tag)
+		return w.Write([]byte(core.Render(c, path)))
+	})
+}
+
+// renderTypedValue is used to render the placeholder and convert the result to the given type
+func renderTypedValue(c *core.Context, path string, typ string) (interface{}, error) {
+	val := strings.TrimSpace(core.Render(c, path))
+	switch typ {
+	case "number":
+		var number json.Number
+		if err := json.Unmarshal([]byte(val), &number); err != nil {
+			return nil, fmt.Errorf("value of %s is not a number: %q", path, val)
+		}
+		return number, nil
+	case "bool":
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return nil, fmt.Errorf("value of %s is not a bool: %q", path, val)
+		}
+		return b, nil
+	default:
+		// values which are not valid JSON, such as plain strings, are kept as strings
+		var v interface{}
+		if err := json.Unmarshal([]byte(val), &v); err != nil {
+			return val, nil
+		}
+		return v, nil
+	}
+}
+
+// splitPlaceholder is used to split the placeholder into path and type, such as "$request.query.page | number",
+// the type is empty if it is not specified or unknown
+func splitPlaceholder(tag string) (string, string) {
+	tag = strings.TrimSpace(tag)
+	i := strings.LastIndex(tag, "|")
+	if i < 0 {
+		return tag, ""
+	}
+	switch typ := strings.TrimSpace(tag[i+1:]); typ {
+	case "number", "bool", "json":
+		return strings.TrimSpace(tag[:i]), typ
+	default:
+		return tag, ""
+	}
+}
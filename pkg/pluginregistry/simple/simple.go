@@ -16,12 +16,16 @@ package simple
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
-	"strings"
+	"os"
+	"path/filepath"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
-	"github.com/valyala/fasttemplate"
 
 	"github.com/bilibili-base/powermock/pkg/pluginregistry"
 	"github.com/bilibili-base/powermock/pkg/pluginregistry/simple/core"
@@ -32,14 +36,19 @@ import (
 )
 
 var (
-	_ pluginregistry.MockPlugin  = &Plugin{}
-	_ pluginregistry.MatchPlugin = &Plugin{}
+	_ pluginregistry.MockPlugin         = &Plugin{}
+	_ pluginregistry.MatchPlugin        = &Plugin{}
+	_ pluginregistry.ConditionValidator = &Plugin{}
 )
 
+// BlobGetter is used to obtain the uploaded blob of a given name
+type BlobGetter func(name string) (*v1alpha1.Blob, bool)
+
 // Plugin defines the most basic matching and Mock plug-ins
 type Plugin struct {
 	cfg *Config
 
+	blobGetter BlobGetter
 	registerer prometheus.Registerer
 	logger.Logger
 }
@@ -47,12 +56,19 @@ type Plugin struct {
 // Config defines the config structure
 type Config struct {
 	Enable bool
+	// FixturesDir is the directory of files referenced by BodySource
+	FixturesDir string
+	// FakerLocale is the locale of faker data, the data is loaded from <FakerLocaleDir>/<FakerLocale>.json
+	// unless it is en (default)
+	FakerLocale    string
+	FakerLocaleDir string
 }
 
 // NewConfig is used to init config with default values
 func NewConfig() *Config {
 	return &Config{
-		Enable: true,
+		Enable:      true,
+		FakerLocale: "en",
 	}
 }
 
@@ -64,6 +80,9 @@ func (c *Config) IsEnabled() bool {
 
 // RegisterFlagsWithPrefix is used to register flags
 func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
+	f.StringVar(&c.FixturesDir, prefix+"simple.fixturesDir", c.FixturesDir, "directory of files used as response bodies")
+	f.StringVar(&c.FakerLocale, prefix+"simple.fakerLocale", c.FakerLocale, "locale of faker data")
+	f.StringVar(&c.FakerLocaleDir, prefix+"simple.fakerLocaleDir", c.FakerLocaleDir, "directory of faker locale files named <locale>.json")
 }
 
 // Validate is used to validate config and returns error on failure
@@ -71,10 +90,24 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func init() {
+	pluginregistry.Register("simple", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+		return New(cfg, deps.GetBlob, logger, registerer)
+	})
+}
+
 // New is used to init service
-func New(cfg *Config, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+func New(cfg *Config, blobGetter BlobGetter, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+	if blobGetter == nil {
+		return nil, errors.New("blob getter is required")
+	}
+	if err := core.LoadFakerLocale(cfg.FakerLocale, cfg.FakerLocaleDir); err != nil {
+		return nil, err
+	}
 	service := &Plugin{
 		cfg:        cfg,
+		blobGetter: blobGetter,
 		registerer: registerer,
 		Logger:     logger.NewLogger("simplePlugin"),
 	}
@@ -97,15 +130,42 @@ func (s *Plugin) Match(ctx context.Context, request *interact.Request, condition
 		matched, err = false, error(nil)
 	)
 	c := core.NewContext(request)
-	for _, item := range simple.Items {
-		operandX := core.Render(c, item.OperandX)
-		operandY := core.Render(c, item.OperandY)
-		matched, err = core.Match(operandX, item.Operator, operandY)
-		if err != nil {
+	if len(simple.Items) > 0 {
+		matched, err = matchItems(c, simple.Items, simple.UseOrAmongItems)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	if len(simple.All) > 0 {
+		matched, err = matchAll(c, simple.All)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	if len(simple.Any) > 0 {
+		matched, err = matchAny(c, simple.Any)
+		if err != nil || !matched {
 			return false, err
 		}
-		if item.Opposite {
-			matched = !matched
+	}
+	if simple.Not != nil {
+		matched, err = matchExpression(c, simple.Not)
+		if err != nil || matched {
+			return false, err
+		}
+		matched = true
+	}
+	return matched, nil
+}
+
+// matchItems is used to match items joined by AND, or by OR if useOr is true
+func matchItems(c *core.Context, items []*v1alpha1.MockAPI_Condition_SimpleCondition_Item, useOr bool) (bool, error) {
+	var matched bool
+	for _, item := range items {
+		var err error
+		matched, err = matchItem(c, item)
+		if err != nil {
+			return false, err
 		}
 		// if matched {
 		// 	if simple.UseOrAmongItems {
@@ -120,41 +180,285 @@ func (s *Plugin) Match(ctx context.Context, request *interact.Request, condition
 		// }
 		//1. false && And return false
 		//2. true && Or return true
-		if (!matched && !simple.UseOrAmongItems) ||
-			(matched && simple.UseOrAmongItems) {
+		if (!matched && !useOr) ||
+			(matched && useOr) {
 			return matched, nil
 		}
 	}
 	return matched, nil
 }
 
+// matchItem is used to perform the operation of item
+func matchItem(c *core.Context, item *v1alpha1.MockAPI_Condition_SimpleCondition_Item) (bool, error) {
+	var matched bool
+	switch item.Operator {
+	case core.OperatorExists:
+		matched = core.Exists(c, item.OperandX)
+	case core.OperatorNotExists:
+		matched = !core.Exists(c, item.OperandX)
+	default:
+		operandX := core.Render(c, item.OperandX)
+		operandY := core.Render(c, item.OperandY)
+		var err error
+		matched, err = core.Match(operandX, item.Operator, operandY)
+		if err != nil {
+			return false, err
+		}
+	}
+	if item.Opposite {
+		matched = !matched
+	}
+	return matched, nil
+}
+
+// matchExpression is used to evaluate the nested expression with short-circuiting
+func matchExpression(c *core.Context, expr *v1alpha1.MockAPI_Condition_SimpleCondition_Expression) (bool, error) {
+	switch {
+	case expr.Item != nil:
+		return matchItem(c, expr.Item)
+	case len(expr.All) > 0:
+		return matchAll(c, expr.All)
+	case len(expr.Any) > 0:
+		return matchAny(c, expr.Any)
+	case expr.Not != nil:
+		matched, err := matchExpression(c, expr.Not)
+		return !matched, err
+	default:
+		return false, errors.New("expression is empty")
+	}
+}
+
+func matchAll(c *core.Context, exprs []*v1alpha1.MockAPI_Condition_SimpleCondition_Expression) (bool, error) {
+	for _, expr := range exprs {
+		matched, err := matchExpression(c, expr)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func matchAny(c *core.Context, exprs []*v1alpha1.MockAPI_Condition_SimpleCondition_Expression) (bool, error) {
+	for _, expr := range exprs {
+		matched, err := matchExpression(c, expr)
+		if err != nil {
+			return false, err
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ValidateCondition is used to check the operators and static operands of simple condition,
+// and that exactly one field of each nested expression is set
+func (s *Plugin) ValidateCondition(condition *v1alpha1.MockAPI_Condition) error {
+	simple := condition.GetSimple()
+	for i, item := range simple.GetItems() {
+		if err := core.ValidateOperator(item.GetOperator(), item.GetOperandY()); err != nil {
+			return fmt.Errorf("item %d: %s", i, err)
+		}
+	}
+	for i, expr := range simple.GetAll() {
+		if err := validateExpression(expr); err != nil {
+			return fmt.Errorf("all %d: %s", i, err)
+		}
+	}
+	for i, expr := range simple.GetAny() {
+		if err := validateExpression(expr); err != nil {
+			return fmt.Errorf("any %d: %s", i, err)
+		}
+	}
+	if not := simple.GetNot(); not != nil {
+		if err := validateExpression(not); err != nil {
+			return fmt.Errorf("not: %s", err)
+		}
+	}
+	return nil
+}
+
+func validateExpression(expr *v1alpha1.MockAPI_Condition_SimpleCondition_Expression) error {
+	var count int
+	if expr.Item != nil {
+		count++
+		if err := core.ValidateOperator(expr.Item.GetOperator(), expr.Item.GetOperandY()); err != nil {
+			return err
+		}
+	}
+	if len(expr.All) > 0 {
+		count++
+		for i, child := range expr.All {
+			if err := validateExpression(child); err != nil {
+				return fmt.Errorf("all %d: %s", i, err)
+			}
+		}
+	}
+	if len(expr.Any) > 0 {
+		count++
+		for i, child := range expr.Any {
+			if err := validateExpression(child); err != nil {
+				return fmt.Errorf("any %d: %s", i, err)
+			}
+		}
+	}
+	if expr.Not != nil {
+		count++
+		if err := validateExpression(expr.Not); err != nil {
+			return fmt.Errorf("not: %s", err)
+		}
+	}
+	if count != 1 {
+		return errors.New("exactly one of item, all, any and not should be set in expression")
+	}
+	return nil
+}
+
 // MockResponse is used to generate interact.Response according to the given MockAPI_Response and interact.Request
 func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request, response *interact.Response) (abort bool, err error) {
 	simple := mock.GetSimple()
 	if simple == nil {
 		return false, nil
 	}
-	c := core.NewContext(request)
+	r, err := newRenderer(core.NewContext(request), simple.GetTemplate())
+	if err != nil {
+		return true, err
+	}
+	if seed := simple.GetFakerSeed(); seed != "" {
+		if err := r.seed(seed); err != nil {
+			return true, err
+		}
+	}
 
 	// Render Code
 	response.Code = simple.GetCode()
+	if codeTemplate := simple.GetCodeTemplate(); codeTemplate != "" {
+		response.Code, err = r.code(codeTemplate)
+		if err != nil {
+			return true, err
+		}
+	}
 	// Render Headers
-	response.Header = map[string]string{}
-	for key, val := range simple.GetHeader() {
-		response.Header[key] = core.Render(c, val)
+	response.Header, err = r.values(simple.GetHeader(), simple.GetHeaders())
+	if err != nil {
+		return true, err
 	}
-	// Render Trailers
-	response.Trailer = map[string]string{}
-	for key, val := range simple.GetTrailer() {
-		response.Trailer[key] = val
+	// Render Cookies
+	for _, cookie := range simple.GetCookies() {
+		value, err := r.cookie(cookie)
+		if err != nil {
+			return true, err
+		}
+		response.Header.Add("set-cookie", value)
 	}
-	// Render Body
-	data, err := fasttemplate.ExecuteFuncStringWithErr(simple.GetBody(), "{{", "}}", func(w io.Writer, tag string) (int, error) {
-		return w.Write([]byte(core.Render(c, strings.TrimSpace(tag))))
-	})
+	// Render Trailers
+	response.Trailer, err = r.values(simple.GetTrailer(), simple.GetTrailers())
 	if err != nil {
 		return true, err
 	}
-	response.Body = interact.NewBytesMessage([]byte(data))
+	// Render Body
+	response.Content = nil
+	if source := simple.GetBodySource(); source != nil {
+		content, contentType, err := s.getContent(source)
+		if err != nil {
+			return true, err
+		}
+		if contentType != "" && response.Header.Get("content-type") == "" {
+			response.Header.Set("content-type", contentType)
+		}
+		response.Content = content
+		response.Body = interact.NewBytesMessage(nil)
+	} else if jsonBody := simple.GetJsonBody(); jsonBody != nil {
+		value, err := r.structured(jsonBody.AsInterface())
+		if err != nil {
+			return true, err
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return true, err
+		}
+		response.Body = interact.NewBytesMessage(data)
+	} else {
+		data, err := r.text(simple.GetBody())
+		if err != nil {
+			return true, err
+		}
+		response.Body = interact.NewBytesMessage([]byte(data))
+	}
+	// Render Status
+	response.Status = nil
+	if status := simple.GetStatus(); status != nil {
+		response.Status, err = r.status(status)
+		if err != nil {
+			return true, err
+		}
+	}
+	// Render Stream
+	response.Stream = nil
+	for _, message := range simple.GetStream() {
+		data, err := r.text(message.GetBody())
+		if err != nil {
+			return true, err
+		}
+		event, err := r.text(message.GetEvent())
+		if err != nil {
+			return true, err
+		}
+		id, err := r.text(message.GetId())
+		if err != nil {
+			return true, err
+		}
+		response.Stream = append(response.Stream, &interact.StreamMessage{
+			Body:  interact.NewBytesMessage([]byte(data)),
+			Delay: uint32(message.GetDelay().AsDuration().Milliseconds()),
+			Event: event,
+			ID:    id,
+		})
+	}
 	return false, nil
 }
+
+// getContent is used to load the binary body from BodySource,
+// the content type of source (or the uploaded blob) is returned as well
+func (s *Plugin) getContent(source *v1alpha1.MockAPI_Response_BodySource) (*interact.Content, string, error) {
+	contentType := source.GetContentType()
+	switch src := source.GetSource().(type) {
+	case *v1alpha1.MockAPI_Response_BodySource_Base64:
+		data, err := base64.StdEncoding.DecodeString(src.Base64)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to decode base64 body: %s", err)
+		}
+		return interact.NewBytesContent("", data), contentType, nil
+	case *v1alpha1.MockAPI_Response_BodySource_File:
+		if s.cfg.FixturesDir == "" {
+			return nil, "", errors.New("fixtures directory is not configured")
+		}
+		// the cleaned absolute path is used to prevent accessing files outside of the fixtures directory
+		path := filepath.Join(s.cfg.FixturesDir, filepath.FromSlash(filepath.Clean("/"+src.File)))
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, "", err
+		}
+		if info.IsDir() {
+			return nil, "", fmt.Errorf("%s is a directory", src.File)
+		}
+		return &interact.Content{
+			Name:    info.Name(),
+			ModTime: info.ModTime(),
+			Open: func() (io.ReadSeekCloser, error) {
+				return os.Open(path)
+			},
+		}, contentType, nil
+	case *v1alpha1.MockAPI_Response_BodySource_Blob:
+		blob, ok := s.blobGetter(src.Blob)
+		if !ok {
+			return nil, "", fmt.Errorf("unable to find blob: %s", src.Blob)
+		}
+		if contentType == "" {
+			contentType = blob.GetContentType()
+		}
+		return interact.NewBytesContent(blob.GetName(), blob.GetData()), contentType, nil
+	default:
+		return nil, "", errors.New("source of body is required")
+	}
+}
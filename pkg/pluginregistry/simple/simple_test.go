@@ -0,0 +1,752 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simple
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func newTestPlugin(t *testing.T) *Plugin {
+	plugin, err := New(NewConfig(), func(name string) (*v1alpha1.Blob, bool) {
+		return nil, false
+	}, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	return plugin
+}
+
+func TestGetContent(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "images"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "images", "a.png"), []byte("png"), 0644))
+	outside := filepath.Join(filepath.Dir(dir), filepath.Base(dir)+".secret")
+	assert.Nil(t, os.WriteFile(outside, []byte("secret"), 0644))
+	t.Cleanup(func() { _ = os.Remove(outside) })
+
+	blobs := map[string]*v1alpha1.Blob{
+		"a.bin": {Name: "a.bin", Data: []byte("blob"), ContentType: "application/x-blob"},
+		"b.bin": {Name: "b.bin", Data: []byte("blob")},
+	}
+	cfg := NewConfig()
+	cfg.FixturesDir = dir
+	plugin, err := New(cfg, func(name string) (*v1alpha1.Blob, bool) {
+		blob, ok := blobs[name]
+		return blob, ok
+	}, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name       string
+		source     *v1alpha1.MockAPI_Response_BodySource
+		noFixtures bool
+		expectName string
+		expectType string
+		expectData string
+		expectErr  bool
+	}{
+		{
+			name: "base64",
+			source: &v1alpha1.MockAPI_Response_BodySource{
+				Source:      &v1alpha1.MockAPI_Response_BodySource_Base64{Base64: "aGVsbG8="},
+				ContentType: "text/plain",
+			},
+			expectType: "text/plain",
+			expectData: "hello",
+		},
+		{
+			name:      "invalid base64",
+			source:    &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_Base64{Base64: "!"}},
+			expectErr: true,
+		},
+		{
+			name:       "file",
+			source:     &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_File{File: "images/a.png"}},
+			expectName: "a.png",
+			expectData: "png",
+		},
+		{
+			name:      "file outside of fixtures directory",
+			source:    &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_File{File: "../" + filepath.Base(outside)}},
+			expectErr: true,
+		},
+		{
+			name:      "directory",
+			source:    &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_File{File: "images"}},
+			expectErr: true,
+		},
+		{
+			name:      "file not found",
+			source:    &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_File{File: "b.png"}},
+			expectErr: true,
+		},
+		{
+			name:       "fixtures directory is not configured",
+			source:     &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_File{File: "images/a.png"}},
+			noFixtures: true,
+			expectErr:  true,
+		},
+		{
+			name:       "blob",
+			source:     &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_Blob{Blob: "a.bin"}},
+			expectName: "a.bin",
+			expectType: "application/x-blob",
+			expectData: "blob",
+		},
+		{
+			name: "content type of blob is overridden",
+			source: &v1alpha1.MockAPI_Response_BodySource{
+				Source:      &v1alpha1.MockAPI_Response_BodySource_Blob{Blob: "a.bin"},
+				ContentType: "image/png",
+			},
+			expectName: "a.bin",
+			expectType: "image/png",
+			expectData: "blob",
+		},
+		{
+			name:       "blob without content type",
+			source:     &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_Blob{Blob: "b.bin"}},
+			expectName: "b.bin",
+			expectData: "blob",
+		},
+		{
+			name:      "blob not found",
+			source:    &v1alpha1.MockAPI_Response_BodySource{Source: &v1alpha1.MockAPI_Response_BodySource_Blob{Blob: "c.bin"}},
+			expectErr: true,
+		},
+		{
+			name:      "empty source",
+			source:    &v1alpha1.MockAPI_Response_BodySource{},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		cfg.FixturesDir = dir
+		if test.noFixtures {
+			cfg.FixturesDir = ""
+		}
+		content, contentType, err := plugin.getContent(test.source)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expectName, content.Name, test.name)
+		assert.Equal(t, test.expectType, contentType, test.name)
+		data, err := content.ReadAll()
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expectData, string(data), test.name)
+	}
+
+	_, err = New(NewConfig(), nil, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.NotNil(t, err)
+}
+
+func TestMockResponseJSONBody(t *testing.T) {
+	plugin := newTestPlugin(t)
+	request := &interact.Request{
+		Protocol: interact.ProtocolHTTP,
+		Method:   "GET",
+		Path:     "/users",
+		Header:   interact.Values{"x-flag": []string{"true"}},
+		Query:    map[string][]string{"page": {"2"}, "name": {"powermock"}, "filter": {`{"age": 3}`}},
+	}
+	tests := []struct {
+		name      string
+		jsonBody  interface{}
+		expect    string
+		expectErr bool
+	}{
+		{
+			name:     "constants",
+			jsonBody: map[string]interface{}{"id": 1, "ok": true, "tags": []interface{}{"a", nil}},
+			expect:   `{"id": 1, "ok": true, "tags": ["a", null]}`,
+		},
+		{
+			name:     "string placeholders",
+			jsonBody: map[string]interface{}{"name": "{{ $request.query.name }}", "message": "hello {{ $request.query.name }}"},
+			expect:   `{"name": "powermock", "message": "hello powermock"}`,
+		},
+		{
+			name: "typed placeholders",
+			jsonBody: map[string]interface{}{
+				"page":   "{{ $request.query.page | number }}",
+				"flag":   " {{ $request.header.x-flag | bool }} ",
+				"filter": "{{ $request.query.filter | json }}",
+				"name":   "{{ $request.query.name | json }}",
+				"list":   []interface{}{"{{ $request.query.page | number }}"},
+			},
+			expect: `{"page": 2, "flag": true, "filter": {"age": 3}, "name": "powermock", "list": [2]}`,
+		},
+		{
+			name:     "type is ignored with other text",
+			jsonBody: map[string]interface{}{"page": "page {{ $request.query.page | number }}"},
+			expect:   `{"page": "page 2"}`,
+		},
+		{
+			name:     "unknown type",
+			jsonBody: "{{ $request.query.page | unknown }}",
+			expect:   `""`,
+		},
+		{
+			name:      "not a number",
+			jsonBody:  "{{ $request.query.name | number }}",
+			expectErr: true,
+		},
+		{
+			name:      "not a bool",
+			jsonBody:  "{{ $request.query.name | bool }}",
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		jsonBody, err := structpb.NewValue(test.jsonBody)
+		assert.Nil(t, err, test.name)
+		mock := &v1alpha1.MockAPI_Response{Response: &v1alpha1.MockAPI_Response_Simple{
+			Simple: &v1alpha1.MockAPI_Response_SimpleResponse{JsonBody: jsonBody},
+		}}
+		response := &interact.Response{}
+		_, err = plugin.MockResponse(context.TODO(), mock, request, response)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.JSONEq(t, test.expect, string(response.Body.Bytes()), test.name)
+	}
+}
+
+func TestSplitPlaceholder(t *testing.T) {
+	tests := []struct {
+		tag        string
+		expectPath string
+		expectType string
+	}{
+		{tag: " $request.query.page ", expectPath: "$request.query.page"},
+		{tag: "$request.query.page | number", expectPath: "$request.query.page", expectType: "number"},
+		{tag: "$request.query.flag|bool", expectPath: "$request.query.flag", expectType: "bool"},
+		{tag: "$request.body | json ", expectPath: "$request.body", expectType: "json"},
+		{tag: "$request.query.page | unknown", expectPath: "$request.query.page | unknown"},
+	}
+	for _, test := range tests {
+		path, typ := splitPlaceholder(test.tag)
+		assert.Equal(t, test.expectPath, path, test.tag)
+		assert.Equal(t, test.expectType, typ, test.tag)
+	}
+}
+
+func TestMockResponseStatus(t *testing.T) {
+	plugin := newTestPlugin(t)
+	request := &interact.Request{
+		Protocol: interact.ProtocolGRPC,
+		Path:     "/test.Greeter/Hello",
+		Header:   interact.Values{"x-user": []string{"powermock"}, "x-limit": []string{"10"}},
+	}
+	value, err := structpb.NewValue(map[string]interface{}{
+		"user":  "{{ $request.header.x-user }}",
+		"limit": "{{ $request.header.x-limit | number }}",
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name          string
+		status        *v1alpha1.MockAPI_Response_Status
+		expectMessage string
+		expectDetails map[string]string
+		expectErr     bool
+	}{
+		{
+			name:          "message",
+			status:        &v1alpha1.MockAPI_Response_Status{Message: "user {{ $request.header.x-user }} is not found"},
+			expectMessage: "user powermock is not found",
+		},
+		{
+			name: "details",
+			status: &v1alpha1.MockAPI_Response_Status{
+				Message: "invalid",
+				Details: []*v1alpha1.MockAPI_Response_StatusDetail{
+					{Detail: &v1alpha1.MockAPI_Response_StatusDetail_ErrorInfo_{ErrorInfo: &v1alpha1.MockAPI_Response_StatusDetail_ErrorInfo{
+						Reason: "NOT_FOUND", Domain: "powermock", Metadata: map[string]string{"a": "b"},
+					}}},
+					{Detail: &v1alpha1.MockAPI_Response_StatusDetail_BadRequest_{BadRequest: &v1alpha1.MockAPI_Response_StatusDetail_BadRequest{
+						FieldViolations: []*v1alpha1.MockAPI_Response_StatusDetail_BadRequest_FieldViolation{{Field: "name", Description: "required"}},
+					}}},
+					{Detail: &v1alpha1.MockAPI_Response_StatusDetail_RetryInfo_{RetryInfo: &v1alpha1.MockAPI_Response_StatusDetail_RetryInfo{
+						RetryDelay: durationpb.New(time.Second),
+					}}},
+					{Detail: &v1alpha1.MockAPI_Response_StatusDetail_Message_{Message: &v1alpha1.MockAPI_Response_StatusDetail_Message{
+						Type: "test.Quota", Value: value,
+					}}},
+					{Detail: &v1alpha1.MockAPI_Response_StatusDetail_Message_{Message: &v1alpha1.MockAPI_Response_StatusDetail_Message{
+						Type: "test.Empty",
+					}}},
+				},
+			},
+			expectMessage: "invalid",
+			expectDetails: map[string]string{
+				"google.rpc.ErrorInfo":  `{"reason": "NOT_FOUND", "domain": "powermock", "metadata": {"a": "b"}}`,
+				"google.rpc.BadRequest": `{"fieldViolations": [{"field": "name", "description": "required"}]}`,
+				"google.rpc.RetryInfo":  `{"retryDelay": "1s"}`,
+				"test.Quota":            `{"user": "powermock", "limit": 10}`,
+				"test.Empty":            `{}`,
+			},
+		},
+		{
+			name: "message without type",
+			status: &v1alpha1.MockAPI_Response_Status{Details: []*v1alpha1.MockAPI_Response_StatusDetail{
+				{Detail: &v1alpha1.MockAPI_Response_StatusDetail_Message_{Message: &v1alpha1.MockAPI_Response_StatusDetail_Message{}}},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "empty detail",
+			status: &v1alpha1.MockAPI_Response_Status{Details: []*v1alpha1.MockAPI_Response_StatusDetail{
+				{},
+			}},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		mock := &v1alpha1.MockAPI_Response{Response: &v1alpha1.MockAPI_Response_Simple{
+			Simple: &v1alpha1.MockAPI_Response_SimpleResponse{Code: 5, Status: test.status},
+		}}
+		response := &interact.Response{}
+		_, err := plugin.MockResponse(context.TODO(), mock, request, response)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, uint32(5), response.Code, test.name)
+		assert.Equal(t, test.expectMessage, response.Status.Message, test.name)
+		assert.Len(t, response.Status.Details, len(test.expectDetails), test.name)
+		for _, detail := range response.Status.Details {
+			assert.JSONEq(t, test.expectDetails[detail.Type], string(detail.Body.Bytes()), detail.Type)
+		}
+	}
+}
+
+func TestMockResponseHeaders(t *testing.T) {
+	plugin := newTestPlugin(t)
+	mock := &v1alpha1.MockAPI_Response{Response: &v1alpha1.MockAPI_Response_Simple{
+		Simple: &v1alpha1.MockAPI_Response_SimpleResponse{
+			Header: map[string]string{"X-Tag": "a", "x-user": "$request.header.x-user"},
+			Headers: map[string]*v1alpha1.MockAPI_Response_Values{
+				"x-tag": {Values: []string{"b", "c"}},
+			},
+			Cookies: []*v1alpha1.MockAPI_Response_Cookie{
+				{Name: "session", Value: "$request.header.x-user", Path: "/", MaxAge: 60, HttpOnly: true},
+				{Name: "theme", Value: "dark", Secure: true, SameSite: "Strict"},
+			},
+			Trailer: map[string]string{"X-Checksum": "sum"},
+			Trailers: map[string]*v1alpha1.MockAPI_Response_Values{
+				"x-step": {Values: []string{"1", "2"}},
+			},
+		},
+	}}
+	request := &interact.Request{Protocol: interact.ProtocolHTTP, Header: interact.Values{"x-user": []string{"powermock"}}}
+	response := &interact.Response{}
+	_, err := plugin.MockResponse(context.TODO(), mock, request, response)
+	assert.Nil(t, err)
+	assert.Equal(t, interact.Values{
+		"x-tag":  []string{"a", "b", "c"},
+		"x-user": []string{"powermock"},
+		"set-cookie": []string{
+			"session=powermock; Path=/; Max-Age=60; HttpOnly",
+			"theme=dark; Secure; SameSite=Strict",
+		},
+	}, response.Header)
+	assert.Equal(t, interact.Values{"x-checksum": []string{"sum"}, "x-step": []string{"1", "2"}}, response.Trailer)
+}
+
+func TestMockResponseTemplate(t *testing.T) {
+	plugin := newTestPlugin(t)
+	request := &interact.Request{
+		Protocol: interact.ProtocolHTTP,
+		Method:   "GET",
+		Path:     "/users/1",
+		Header:   interact.Values{"x-user": []string{"powermock"}},
+		Query:    map[string][]string{"code": {"201"}},
+	}
+	tests := []struct {
+		name         string
+		simple       *v1alpha1.MockAPI_Response_SimpleResponse
+		expectCode   uint32
+		expectHeader interact.Values
+		expectBody   string
+		expectErr    bool
+	}{
+		{
+			name: "placeholder",
+			simple: &v1alpha1.MockAPI_Response_SimpleResponse{
+				Code:   200,
+				Header: map[string]string{"x-user": "$request.header.x-user", "x-path": "{{ .request.path }}"},
+				Body:   `{"user": "{{ $request.header.x-user }}", "path": "{{ .request.path }}"}`,
+			},
+			expectCode:   200,
+			expectHeader: interact.Values{"x-user": []string{"powermock"}, "x-path": []string{"{{ .request.path }}"}},
+			expectBody:   `{"user": "powermock", "path": ".request.path"}`,
+		},
+		{
+			name: "go",
+			simple: &v1alpha1.MockAPI_Response_SimpleResponse{
+				Template:     "go",
+				Code:         200,
+				CodeTemplate: "{{ .request.query.code }}",
+				Header:       map[string]string{"x-user": `{{ index .request.header "x-user" | upper }}`, "x-path": "$request.path"},
+				Cookies:      []*v1alpha1.MockAPI_Response_Cookie{{Name: "user", Value: `{{ index .request.header "x-user" }}`}},
+				Body:         `{"user": "{{ index .request.header "x-user" }}", "path": "{{ .request.path }}"}`,
+			},
+			expectCode: 201,
+			expectHeader: interact.Values{
+				"x-user":     []string{"POWERMOCK"},
+				"x-path":     []string{"$request.path"},
+				"set-cookie": []string{"user=powermock"},
+			},
+			expectBody: `{"user": "powermock", "path": "/users/1"}`,
+		},
+		{
+			name: "code template with placeholder",
+			simple: &v1alpha1.MockAPI_Response_SimpleResponse{
+				CodeTemplate: "{{ $request.query.code }}",
+			},
+			expectCode:   201,
+			expectHeader: interact.Values{},
+			expectBody:   ``,
+		},
+		{
+			name:      "code template is not a number",
+			simple:    &v1alpha1.MockAPI_Response_SimpleResponse{Template: "go", CodeTemplate: "{{ .request.path }}"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid go template",
+			simple:    &v1alpha1.MockAPI_Response_SimpleResponse{Template: "go", Body: "{{ .request.path"},
+			expectErr: true,
+		},
+		{
+			name:      "unknown engine",
+			simple:    &v1alpha1.MockAPI_Response_SimpleResponse{Template: "unknown"},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		mock := &v1alpha1.MockAPI_Response{Response: &v1alpha1.MockAPI_Response_Simple{Simple: test.simple}}
+		response := &interact.Response{}
+		_, err := plugin.MockResponse(context.TODO(), mock, request, response)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expectCode, response.Code, test.name)
+		assert.Equal(t, test.expectHeader, response.Header, test.name)
+		assert.Equal(t, test.expectBody, string(response.Body.Bytes()), test.name)
+	}
+}
+
+func TestMockResponseFakerSeed(t *testing.T) {
+	plugin := newTestPlugin(t)
+	tests := []struct {
+		template string
+		seed     string
+		body     string
+	}{
+		{
+			seed: "$request.header.uid",
+			body: `{{ $mock.name }} {{ $mock.number(1, 1000000) }}`,
+		},
+		{
+			template: "go",
+			seed:     `{{ index .request.header "uid" }}`,
+			body:     `{{ mock "name" }} {{ randInt 1 1000000 }} {{ uuid }}`,
+		},
+	}
+	for _, test := range tests {
+		mockResponse := func(uid string) string {
+			mock := &v1alpha1.MockAPI_Response{Response: &v1alpha1.MockAPI_Response_Simple{
+				Simple: &v1alpha1.MockAPI_Response_SimpleResponse{
+					Template:  test.template,
+					FakerSeed: test.seed,
+					Body:      test.body,
+				},
+			}}
+			request := &interact.Request{Protocol: interact.ProtocolHTTP, Header: interact.Values{"uid": []string{uid}}}
+			response := &interact.Response{}
+			_, err := plugin.MockResponse(context.TODO(), mock, request, response)
+			assert.Nil(t, err, test.body)
+			return string(response.Body.Bytes())
+		}
+		assert.Equal(t, mockResponse("1"), mockResponse("1"), test.body)
+		assert.NotEqual(t, mockResponse("1"), mockResponse("2"), test.body)
+	}
+}
+
+func TestMockResponseFakerSeedWithMaps(t *testing.T) {
+	plugin := newTestPlugin(t)
+	fields := map[string]interface{}{}
+	header := map[string]string{}
+	headers := map[string]*v1alpha1.MockAPI_Response_Values{}
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		fields[key] = map[string]interface{}{"name": "{{ $mock.name }}", "id": "{{ $mock.number(1, 1000000)|int }}"}
+		header["x-"+key] = "$mock.uuid"
+		headers["x-multi-"+key] = &v1alpha1.MockAPI_Response_Values{Values: []string{"$mock.uuid", "$mock.uuid"}}
+	}
+	mockResponse := func() (string, interact.Values) {
+		jsonBody, err := structpb.NewValue(fields)
+		assert.Nil(t, err)
+		mock := &v1alpha1.MockAPI_Response{Response: &v1alpha1.MockAPI_Response_Simple{
+			Simple: &v1alpha1.MockAPI_Response_SimpleResponse{
+				FakerSeed: "seed",
+				Header:    header,
+				Headers:   headers,
+				JsonBody:  jsonBody,
+			},
+		}}
+		response := &interact.Response{}
+		_, err = plugin.MockResponse(context.TODO(), mock, &interact.Request{Protocol: interact.ProtocolHTTP}, response)
+		assert.Nil(t, err)
+		return string(response.Body.Bytes()), response.Header
+	}
+	expectBody, expectHeader := mockResponse()
+	for i := 0; i < 10; i++ {
+		body, header := mockResponse()
+		assert.Equal(t, expectBody, body)
+		assert.Equal(t, expectHeader, header)
+	}
+}
+
+func TestMatchItems(t *testing.T) {
+	plugin := newTestPlugin(t)
+	request := &interact.Request{
+		Protocol: interact.ProtocolHTTP,
+		Header:   interact.Values{"uid": []string{"1001"}, "x-empty": []string{""}, "x-version": []string{"1.10.0"}},
+		IP:       "10.0.0.1",
+	}
+	type item = v1alpha1.MockAPI_Condition_SimpleCondition_Item
+	tests := []struct {
+		name      string
+		items     []*item
+		useOr     bool
+		expect    bool
+		expectErr bool
+	}{
+		{
+			name:   "and",
+			items:  []*item{{OperandX: "$request.header.uid", Operator: ">", OperandY: "1000"}, {OperandX: "$request.ip", Operator: "cidr", OperandY: "10.0.0.0/8"}},
+			expect: true,
+		},
+		{
+			name:   "and with mismatch",
+			items:  []*item{{OperandX: "$request.header.uid", Operator: ">", OperandY: "1000"}, {OperandX: "$request.ip", Operator: "cidr", OperandY: "192.168.0.0/16"}},
+			expect: false,
+		},
+		{
+			name:   "or",
+			items:  []*item{{OperandX: "$request.header.uid", Operator: "<", OperandY: "1000"}, {OperandX: "$request.header.x-version", Operator: "semver>", OperandY: "1.9.0"}},
+			useOr:  true,
+			expect: true,
+		},
+		{
+			name:   "opposite",
+			items:  []*item{{OperandX: "$request.header.uid", Operator: "oneOf", OperandY: "1, 2", Opposite: true}},
+			expect: true,
+		},
+		{
+			name:   "exists with empty value",
+			items:  []*item{{OperandX: "$request.header.x-empty", Operator: "exists"}},
+			expect: true,
+		},
+		{
+			name:   "notExists",
+			items:  []*item{{OperandX: "$request.header.unknown", Operator: "notExists"}},
+			expect: true,
+		},
+		{
+			name:      "unknown operator",
+			items:     []*item{{OperandX: "$request.header.uid", Operator: "like", OperandY: "1"}},
+			expectErr: true,
+		},
+	}
+	for _, test := range tests {
+		condition := &v1alpha1.MockAPI_Condition{Condition: &v1alpha1.MockAPI_Condition_Simple{
+			Simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Items: test.items, UseOrAmongItems: test.useOr},
+		}}
+		matched, err := plugin.Match(context.TODO(), request, condition)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expect, matched, test.name)
+	}
+}
+
+func TestValidateCondition(t *testing.T) {
+	plugin := newTestPlugin(t)
+	type item = v1alpha1.MockAPI_Condition_SimpleCondition_Item
+	tests := []struct {
+		name      string
+		items     []*item
+		expectErr bool
+	}{
+		{name: "valid", items: []*item{{Operator: "=="}, {Operator: "regex", OperandY: "^a"}, {Operator: "regex", OperandY: "$request.query.pattern"}}},
+		{name: "unknown operator", items: []*item{{Operator: "=="}, {Operator: "like"}}, expectErr: true},
+		{name: "invalid regex", items: []*item{{Operator: "regex", OperandY: "("}}, expectErr: true},
+		{name: "invalid cidr", items: []*item{{Operator: "cidr", OperandY: "10.0.0.0"}}, expectErr: true},
+	}
+	for _, test := range tests {
+		err := plugin.ValidateCondition(&v1alpha1.MockAPI_Condition{Condition: &v1alpha1.MockAPI_Condition_Simple{
+			Simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Items: test.items},
+		}})
+		assert.Equal(t, test.expectErr, err != nil, test.name)
+	}
+	// conditions of other plugins are ignored
+	assert.Nil(t, plugin.ValidateCondition(&v1alpha1.MockAPI_Condition{}))
+}
+
+func TestMatchExpressions(t *testing.T) {
+	plugin := newTestPlugin(t)
+	request := &interact.Request{
+		Protocol: interact.ProtocolHTTP,
+		Header:   interact.Values{"uid": []string{"1001"}, "region": []string{"sh"}},
+	}
+	type expr = v1alpha1.MockAPI_Condition_SimpleCondition_Expression
+	type item = v1alpha1.MockAPI_Condition_SimpleCondition_Item
+	uid := func(operator, operandY string) *expr {
+		return &expr{Item: &item{OperandX: "$request.header.uid", Operator: operator, OperandY: operandY}}
+	}
+	region := func(operandY string) *expr {
+		return &expr{Item: &item{OperandX: "$request.header.region", Operator: "==", OperandY: operandY}}
+	}
+	// invalid is an expression which returns error if it is evaluated
+	invalid := &expr{Item: &item{Operator: "like"}}
+	tests := []struct {
+		name      string
+		simple    *v1alpha1.MockAPI_Condition_SimpleCondition
+		expect    bool
+		expectErr bool
+	}{
+		{name: "empty", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{}, expect: false},
+		{name: "all", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{All: []*expr{uid(">", "1000"), region("sh")}}, expect: true},
+		{name: "all with mismatch", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{All: []*expr{uid(">", "1000"), region("bj")}}, expect: false},
+		{name: "any", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Any: []*expr{region("bj"), region("sh")}}, expect: true},
+		{name: "any with mismatch", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Any: []*expr{region("bj"), region("gz")}}, expect: false},
+		{name: "not", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Not: region("bj")}, expect: true},
+		{name: "not with match", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Not: region("sh")}, expect: false},
+		{
+			name: "nested",
+			simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Any: []*expr{
+				{All: []*expr{region("bj"), uid(">", "1000")}},
+				{All: []*expr{region("sh"), {Not: uid("<", "1000")}}},
+			}},
+			expect: true,
+		},
+		{
+			name: "items and groups",
+			simple: &v1alpha1.MockAPI_Condition_SimpleCondition{
+				Items: []*item{{OperandX: "$request.header.uid", Operator: "==", OperandY: "1001"}},
+				Any:   []*expr{region("sh")},
+				Not:   uid("==", "1"),
+			},
+			expect: true,
+		},
+		{
+			name: "items mismatch",
+			simple: &v1alpha1.MockAPI_Condition_SimpleCondition{
+				Items: []*item{{OperandX: "$request.header.uid", Operator: "==", OperandY: "1"}},
+				Any:   []*expr{region("sh")},
+			},
+			expect: false,
+		},
+		{name: "short-circuit of all", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{All: []*expr{region("bj"), invalid}}, expect: false},
+		{name: "short-circuit of any", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Any: []*expr{region("sh"), invalid}}, expect: true},
+		{name: "error", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Any: []*expr{region("bj"), invalid}}, expectErr: true},
+		{name: "error of not", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{Not: invalid}, expectErr: true},
+		{name: "empty expression", simple: &v1alpha1.MockAPI_Condition_SimpleCondition{All: []*expr{{}}}, expectErr: true},
+	}
+	for _, test := range tests {
+		condition := &v1alpha1.MockAPI_Condition{Condition: &v1alpha1.MockAPI_Condition_Simple{Simple: test.simple}}
+		matched, err := plugin.Match(context.TODO(), request, condition)
+		if test.expectErr {
+			assert.NotNil(t, err, test.name)
+			continue
+		}
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expect, matched, test.name)
+	}
+}
+
+func TestValidateExpressions(t *testing.T) {
+	plugin := newTestPlugin(t)
+	type expr = v1alpha1.MockAPI_Condition_SimpleCondition_Expression
+	type item = v1alpha1.MockAPI_Condition_SimpleCondition_Item
+	valid := &expr{Item: &item{Operator: "=="}}
+	tests := []struct {
+		name      string
+		simple    *v1alpha1.MockAPI_Condition_SimpleCondition
+		expectErr string
+	}{
+		{
+			name: "valid",
+			simple: &v1alpha1.MockAPI_Condition_SimpleCondition{
+				All: []*expr{valid, {Any: []*expr{valid, {Not: valid}}}},
+				Any: []*expr{valid},
+				Not: &expr{All: []*expr{valid}},
+			},
+		},
+		{
+			name:      "unknown operator",
+			simple:    &v1alpha1.MockAPI_Condition_SimpleCondition{All: []*expr{valid, {Any: []*expr{{Item: &item{Operator: "like"}}}}}},
+			expectErr: "all 1: any 0: unknown operator: like",
+		},
+		{
+			name:      "empty expression",
+			simple:    &v1alpha1.MockAPI_Condition_SimpleCondition{Any: []*expr{{}}},
+			expectErr: "any 0: exactly one of item, all, any and not should be set in expression",
+		},
+		{
+			name:      "multiple fields",
+			simple:    &v1alpha1.MockAPI_Condition_SimpleCondition{Not: &expr{Item: valid.Item, Not: valid}},
+			expectErr: "not: exactly one of item, all, any and not should be set in expression",
+		},
+		{
+			name:      "invalid operand",
+			simple:    &v1alpha1.MockAPI_Condition_SimpleCondition{Not: &expr{Not: &expr{Item: &item{Operator: "regex", OperandY: "("}}}},
+			expectErr: "not: not: invalid operandY of regex",
+		},
+	}
+	for _, test := range tests {
+		err := plugin.ValidateCondition(&v1alpha1.MockAPI_Condition{Condition: &v1alpha1.MockAPI_Condition_Simple{Simple: test.simple}})
+		if test.expectErr == "" {
+			assert.Nil(t, err, test.name)
+			continue
+		}
+		if assert.NotNil(t, err, test.name) {
+			assert.Contains(t, err.Error(), test.expectErr, test.name)
+		}
+	}
+}
@@ -83,6 +83,13 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+func init() {
+	pluginregistry.Register("redis", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (pluginregistry.StoragePlugin, error) {
+		return New(cfg, logger, registerer)
+	})
+}
+
 // New is used to init service
 func New(cfg *Config, logger logger.Logger, registerer prometheus.Registerer) (pluginregistry.StoragePlugin, error) {
 	s := &Plugin{
@@ -0,0 +1,177 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+)
+
+// defines the functions of ABI, see docs/wasm.md for details
+const (
+	// exportAlloc allocates the memory of input: alloc(size i32) -> ptr i32
+	exportAlloc = "alloc"
+	// exportMatch and exportMock handle the input: fn(ptr i32, size i32) -> (ptr << 32 | size) i64
+	exportMatch = "match"
+	exportMock  = "mock"
+	// exportMemory is the memory shared with the host
+	exportMemory = "memory"
+	// hostModule provides the functions imported by modules, such as log(ptr i32, size i32)
+	hostModule = "powermock"
+	// initialize is called after the module is instantiated if it is exported,
+	// it initializes the runtime of reactor modules, such as modules built by Go and TinyGo
+	initialize = "_initialize"
+)
+
+type moduleNameKey struct{}
+
+// blobModule is the module compiled from the blob, it is recompiled if the blob is changed
+type blobModule struct {
+	sum      [sha256.Size]byte
+	compiled wazero.CompiledModule
+}
+
+// moduleCache keeps the modules loaded from files and compiled from blobs
+type moduleCache struct {
+	files map[string]wazero.CompiledModule
+	lock  sync.Mutex
+	blobs map[string]*blobModule
+}
+
+// loadModules is used to compile all <name>.wasm files of dir
+func loadModules(ctx context.Context, runtime wazero.Runtime, dir string) (*moduleCache, error) {
+	modules := &moduleCache{
+		files: map[string]wazero.CompiledModule{},
+		blobs: map[string]*blobModule{},
+	}
+	if dir == "" {
+		return modules, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.wasm"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		compiled, err := runtime.CompileModule(ctx, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile wasm module %s: %s", path, err)
+		}
+		modules.files[strings.TrimSuffix(filepath.Base(path), ".wasm")] = compiled
+	}
+	return modules, nil
+}
+
+// getModule returns the module loaded from files, or compiled from the uploaded blob of the same name
+func (s *Plugin) getModule(ctx context.Context, name string) (wazero.CompiledModule, error) {
+	if compiled, ok := s.modules.files[name]; ok {
+		return compiled, nil
+	}
+	blob, ok := s.blobGetter(name)
+	if !ok {
+		return nil, fmt.Errorf("wasm module not found: %s", name)
+	}
+	sum := sha256.Sum256(blob.GetData())
+	s.modules.lock.Lock()
+	defer s.modules.lock.Unlock()
+	if module, ok := s.modules.blobs[name]; ok && module.sum == sum {
+		return module.compiled, nil
+	}
+	// the compilation is not limited by the timeout of execution
+	compiled, err := s.runtime.CompileModule(context.Background(), blob.GetData())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile wasm module %s: %s", name, err)
+	}
+	if module, ok := s.modules.blobs[name]; ok {
+		_ = module.compiled.Close(ctx)
+	}
+	s.modules.blobs[name] = &blobModule{sum: sum, compiled: compiled}
+	return compiled, nil
+}
+
+// invoke is used to instantiate the module, write the input to its memory and call the exported function
+// Each call uses a new instance so that calls are isolated from each other
+func (s *Plugin) invoke(ctx context.Context, name string, compiled wazero.CompiledModule, export string, input []byte) ([]byte, error) {
+	ctx = context.WithValue(ctx, moduleNameKey{}, name)
+	instance, err := s.runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions(initialize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate wasm module %s: %s", name, err)
+	}
+	defer instance.Close(context.Background())
+	alloc := instance.ExportedFunction(exportAlloc)
+	fn := instance.ExportedFunction(export)
+	if alloc == nil || fn == nil {
+		return nil, fmt.Errorf("wasm module %s should export %s and %s", name, exportAlloc, export)
+	}
+	memory := instance.ExportedMemory(exportMemory)
+	if memory == nil {
+		return nil, fmt.Errorf("wasm module %s should export %s", name, exportMemory)
+	}
+	results, err := alloc.Call(ctx, uint64(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s of wasm module %s: %s", exportAlloc, name, err)
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("%s of wasm module %s should return a pointer", exportAlloc, name)
+	}
+	ptr := uint32(results[0])
+	if !memory.Write(ptr, input) {
+		return nil, fmt.Errorf("memory allocated by wasm module %s is out of range", name)
+	}
+	results, err = fn.Call(ctx, uint64(ptr), uint64(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s of wasm module %s: %s", export, name, err)
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("%s of wasm module %s should return the pointer and size of output", export, name)
+	}
+	outputPtr, outputSize := uint32(results[0]>>32), uint32(results[0])
+	output, ok := memory.Read(outputPtr, outputSize)
+	if !ok {
+		return nil, fmt.Errorf("output of wasm module %s is out of range", name)
+	}
+	// the memory is released when the instance is closed
+	return append([]byte(nil), output...), nil
+}
+
+// instantiateHostModule is used to provide the functions imported by modules
+func (s *Plugin) instantiateHostModule(ctx context.Context) error {
+	_, err := s.runtime.NewHostModuleBuilder(hostModule).
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, m api.Module, ptr uint32, size uint32) {
+			message, ok := m.Memory().Read(ptr, size)
+			if !ok {
+				return
+			}
+			name, _ := ctx.Value(moduleNameKey{}).(string)
+			s.LogInfo(map[string]interface{}{"module": name}, "%s", message)
+		}).
+		Export("log").
+		Instantiate(ctx)
+	return err
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/pluginregistry"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+var _ pluginregistry.MockPlugin = &Plugin{}
+var _ pluginregistry.MatchPlugin = &Plugin{}
+
+// BlobGetter is used to obtain the uploaded blob of a given name
+type BlobGetter func(name string) (*v1alpha1.Blob, bool)
+
+// Plugin implements conditions and responses by WASM modules
+type Plugin struct {
+	cfg        *Config
+	runtime    wazero.Runtime
+	blobGetter BlobGetter
+	modules    *moduleCache
+
+	registerer prometheus.Registerer
+	logger.Logger
+}
+
+// Config defines the config structure
+type Config struct {
+	Enable bool
+	// ModuleDir is the directory of modules, each <name>.wasm file is loaded as the module of name at startup
+	ModuleDir string
+	// Timeout is the default and max time limit of each execution
+	Timeout time.Duration
+	// MemoryLimitPages is the max memory of each module instance in pages of 64KiB
+	MemoryLimitPages uint32
+}
+
+// NewConfig is used to init config with default values
+func NewConfig() *Config {
+	return &Config{
+		Enable:           true,
+		Timeout:          time.Second,
+		MemoryLimitPages: 256,
+	}
+}
+
+// IsEnabled is used to return whether the current component is enabled
+// This attribute is required in pluggable components
+func (c *Config) IsEnabled() bool {
+	return c.Enable
+}
+
+// RegisterFlagsWithPrefix is used to register flags
+func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
+	f.BoolVar(&c.Enable, prefix+"wasm.enable", c.Enable, "define whether the component is enabled")
+	f.StringVar(&c.ModuleDir, prefix+"wasm.moduleDir", c.ModuleDir, "directory of WASM modules named <name>.wasm")
+	f.DurationVar(&c.Timeout, prefix+"wasm.timeout", c.Timeout, "default and max time limit of each execution of WASM modules")
+	f.Uint32Var(&c.MemoryLimitPages, prefix+"wasm.memoryLimitPages", c.MemoryLimitPages, "max memory of each WASM module instance in pages of 64KiB")
+}
+
+// Validate is used to validate config and returns error on failure
+func (c *Config) Validate() error {
+	if c.Timeout <= 0 {
+		return errors.New("wasm.timeout should be positive")
+	}
+	if c.MemoryLimitPages == 0 || c.MemoryLimitPages > 65536 {
+		return errors.New("wasm.memoryLimitPages should be in (0, 65536]")
+	}
+	return nil
+}
+
+func init() {
+	pluginregistry.Register("wasm", NewConfig, func(cfg *Config, deps *pluginregistry.Dependencies,
+		logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+		return New(cfg, deps.GetBlob, logger, registerer)
+	})
+}
+
+// New is used to init service
+func New(cfg *Config, blobGetter BlobGetter, logger logger.Logger, registerer prometheus.Registerer) (*Plugin, error) {
+	if blobGetter == nil {
+		return nil, errors.New("blob getter is required")
+	}
+	ctx := context.Background()
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithMemoryLimitPages(cfg.MemoryLimitPages))
+	service := &Plugin{
+		cfg:        cfg,
+		runtime:    runtime,
+		blobGetter: blobGetter,
+		registerer: registerer,
+		Logger:     logger.NewLogger("wasmPlugin"),
+	}
+	// WASI is provided without any file system, arguments or environment variables
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, err
+	}
+	if err := service.instantiateHostModule(ctx); err != nil {
+		return nil, err
+	}
+	modules, err := loadModules(ctx, runtime, cfg.ModuleDir)
+	if err != nil {
+		return nil, err
+	}
+	service.modules = modules
+	for name := range modules.files {
+		service.LogInfo(map[string]interface{}{"module": name}, "wasm module loaded")
+	}
+	return service, nil
+}
+
+// Name is used to return the plugin name
+func (s *Plugin) Name() string {
+	return "wasm"
+}
+
+// Match is used to determine whether interact.Request satisfies the matching condition of MockAPI_Condition
+func (s *Plugin) Match(ctx context.Context, request *interact.Request, condition *v1alpha1.MockAPI_Condition) (match bool, err error) {
+	wasm := condition.GetWasm()
+	if wasm == nil {
+		return false, nil
+	}
+	conditionRaw, err := protojson.Marshal(condition)
+	if err != nil {
+		return false, err
+	}
+	var output struct {
+		Match bool   `json:"match"`
+		Error string `json:"error"`
+	}
+	if err := s.call(ctx, s.cfg.Timeout, wasm.GetModule(), exportMatch, request, "condition", conditionRaw, &output); err != nil {
+		return false, err
+	}
+	if output.Error != "" {
+		return false, errors.New(output.Error)
+	}
+	return output.Match, nil
+}
+
+// MockResponse is used to generate interact.Response according to the given MockAPI_Response and interact.Request
+func (s *Plugin) MockResponse(ctx context.Context, mock *v1alpha1.MockAPI_Response, request *interact.Request, response *interact.Response) (abort bool, err error) {
+	wasm := mock.GetWasm()
+	if wasm == nil {
+		return false, nil
+	}
+	mockRaw, err := protojson.Marshal(mock)
+	if err != nil {
+		return true, err
+	}
+
+	// get timeout
+	timeout := s.cfg.Timeout
+	if t := wasm.GetTimeout(); t != nil && t.AsDuration() > 0 && t.AsDuration() < timeout {
+		timeout = t.AsDuration()
+	}
+	var output struct {
+		Abort    bool            `json:"abort"`
+		Error    string          `json:"error"`
+		Response json.RawMessage `json:"response"`
+	}
+	if err := s.call(ctx, timeout, wasm.GetModule(), exportMock, request, "mock", mockRaw, &output); err != nil {
+		return true, err
+	}
+	if output.Error != "" {
+		return true, errors.New(output.Error)
+	}
+	if len(output.Response) > 0 {
+		if err := jsoniter.Unmarshal(output.Response, &response); err != nil {
+			return true, err
+		}
+	}
+	return output.Abort, nil
+}
+
+// call is used to encode the input as {"request": <request>, "<key>": <value>},
+// call the exported function of module within the timeout and decode the output
+func (s *Plugin) call(ctx context.Context, timeout time.Duration, name string, export string, request *interact.Request,
+	key string, value []byte, output interface{}) error {
+	module, err := s.getModule(ctx, name)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	requestRaw, err := jsoniter.Marshal(request)
+	if err != nil {
+		return err
+	}
+	input, err := json.Marshal(map[string]json.RawMessage{
+		"request": requestRaw,
+		key:       value,
+	})
+	if err != nil {
+		return err
+	}
+	outputRaw, err := s.invoke(ctx, name, module, export, input)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(outputRaw, output); err != nil {
+		return fmt.Errorf("invalid output of wasm module %s: %s", name, err)
+	}
+	return nil
+}
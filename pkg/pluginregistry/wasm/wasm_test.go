@@ -0,0 +1,307 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/interact"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// testModule defines a module whose match and mock functions return constant outputs
+type testModule struct {
+	matchOutput string
+	mockOutput  string
+	// loop makes mock run forever
+	loop bool
+	// withoutAlloc makes the module not export alloc
+	withoutAlloc bool
+	// withoutMemory makes the module not define memory
+	withoutMemory bool
+	// allocWithoutResult makes alloc return nothing
+	allocWithoutResult bool
+}
+
+// build is used to encode the module in the binary format of WASM,
+// the outputs are placed in the data segments at offset 0 and 512, the input is written at offset 1024
+func (m testModule) build() []byte {
+	section := func(id byte, items ...[]byte) []byte {
+		content := uleb(uint64(len(items)))
+		for _, item := range items {
+			content = append(content, item...)
+		}
+		return append(append([]byte{id}, uleb(uint64(len(content)))...), content...)
+	}
+	name := func(s string) []byte {
+		return append(uleb(uint64(len(s))), s...)
+	}
+	body := func(instructions ...byte) []byte {
+		// no locals, and the body is terminated by end
+		content := append([]byte{0x00}, instructions...)
+		content = append(content, 0x0b)
+		return append(uleb(uint64(len(content))), content...)
+	}
+	// returnOutput pushes (offset << 32 | size) of the output
+	returnOutput := func(offset int, output string) []byte {
+		return append([]byte{0x42}, sleb(int64(offset)<<32|int64(len(output)))...)
+	}
+	data := func(offset int, output string) []byte {
+		segment := append([]byte{0x00, 0x41}, sleb(int64(offset))...)
+		segment = append(segment, 0x0b)
+		return append(segment, name(output)...)
+	}
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(1,
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},       // (i32) -> i32
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e}, // (i32, i32) -> i64
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x00},       // (i32, i32) -> ()
+		[]byte{0x60, 0x01, 0x7f, 0x00},             // (i32) -> ()
+	)...)
+	// function 0 is the imported powermock.log
+	module = append(module, section(2, append(append(name(hostModule), name("log")...), 0x00, 0x02))...)
+	allocType, allocBody := byte(0x00), append([]byte{0x41}, sleb(1024)...)
+	if m.allocWithoutResult {
+		allocType, allocBody = 0x03, nil
+	}
+	module = append(module, section(3, []byte{allocType}, []byte{0x01}, []byte{0x01})...)
+	exports := [][]byte{
+		append(name(exportMatch), 0x00, 0x02),
+		append(name(exportMock), 0x00, 0x03),
+	}
+	if !m.withoutMemory {
+		module = append(module, section(5, []byte{0x00, 0x01})...)
+		exports = append(exports, append(name(exportMemory), 0x02, 0x00))
+	}
+	if !m.withoutAlloc {
+		exports = append(exports, append(name(exportAlloc), 0x00, 0x01))
+	}
+	module = append(module, section(7, exports...)...)
+	// mock logs its output before returning it
+	mock := append([]byte{0x41}, sleb(512)...)
+	mock = append(mock, 0x41)
+	mock = append(mock, sleb(int64(len(m.mockOutput)))...)
+	mock = append(mock, 0x10, 0x00)
+	if m.loop {
+		mock = append(mock, 0x03, 0x40, 0x0c, 0x00, 0x0b)
+	}
+	mock = append(mock, returnOutput(512, m.mockOutput)...)
+	module = append(module, section(10,
+		body(allocBody...),
+		body(returnOutput(0, m.matchOutput)...),
+		body(mock...),
+	)...)
+	if !m.withoutMemory {
+		module = append(module, section(11, data(0, m.matchOutput), data(512, m.mockOutput))...)
+	}
+	return module
+}
+
+func uleb(v uint64) []byte {
+	var result []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(result, b)
+		}
+		result = append(result, b|0x80)
+	}
+}
+
+func sleb(v int64) []byte {
+	var result []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(result, b)
+		}
+		result = append(result, b|0x80)
+	}
+}
+
+func newTestPlugin(t *testing.T, modules map[string]testModule, blobs map[string]*v1alpha1.Blob) *Plugin {
+	dir := t.TempDir()
+	for name, module := range modules {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, name+".wasm"), module.build(), 0644))
+	}
+	cfg := NewConfig()
+	cfg.ModuleDir = dir
+	plugin, err := New(cfg, func(name string) (*v1alpha1.Blob, bool) {
+		blob, ok := blobs[name]
+		return blob, ok
+	}, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	return plugin
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       func(cfg *Config)
+		expectErr bool
+	}{
+		{name: "default", cfg: func(cfg *Config) {}},
+		{name: "timeout", cfg: func(cfg *Config) { cfg.Timeout = 0 }, expectErr: true},
+		{name: "no memory", cfg: func(cfg *Config) { cfg.MemoryLimitPages = 0 }, expectErr: true},
+		{name: "too much memory", cfg: func(cfg *Config) { cfg.MemoryLimitPages = 65537 }, expectErr: true},
+	}
+	for _, test := range tests {
+		cfg := NewConfig()
+		test.cfg(cfg)
+		assert.Equal(t, test.expectErr, cfg.Validate() != nil, test.name)
+	}
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "invalid.wasm"), []byte("invalid"), 0644))
+	cfg := NewConfig()
+	cfg.ModuleDir = dir
+	_, err := New(cfg, func(name string) (*v1alpha1.Blob, bool) { return nil, false }, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.NotNil(t, err)
+	_, err = New(NewConfig(), nil, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.NotNil(t, err)
+}
+
+func TestMatch(t *testing.T) {
+	plugin := newTestPlugin(t, map[string]testModule{
+		"matched":    {matchOutput: `{"match": true}`},
+		"mismatched": {matchOutput: `{"match": false}`},
+		"error":      {matchOutput: `{"error": "failed"}`},
+		"invalid":    {matchOutput: `not json`},
+		"noAlloc":    {matchOutput: `{"match": true}`, withoutAlloc: true},
+		"noMemory":   {withoutMemory: true},
+		"allocVoid":  {matchOutput: `{"match": true}`, allocWithoutResult: true},
+	}, map[string]*v1alpha1.Blob{
+		"uploaded": {Name: "uploaded", Data: testModule{matchOutput: `{"match": true}`}.build()},
+		"broken":   {Name: "broken", Data: []byte("invalid")},
+	})
+	tests := []struct {
+		module    string
+		expect    bool
+		expectErr bool
+	}{
+		{module: "matched", expect: true},
+		{module: "mismatched", expect: false},
+		{module: "uploaded", expect: true},
+		{module: "error", expectErr: true},
+		{module: "invalid", expectErr: true},
+		{module: "noAlloc", expectErr: true},
+		{module: "noMemory", expectErr: true},
+		{module: "allocVoid", expectErr: true},
+		{module: "broken", expectErr: true},
+		{module: "unknown", expectErr: true},
+	}
+	request := &interact.Request{Protocol: interact.ProtocolHTTP, Method: "GET", Path: "/hello"}
+	for _, test := range tests {
+		condition := &v1alpha1.MockAPI_Condition{Condition: &v1alpha1.MockAPI_Condition_Wasm{
+			Wasm: &v1alpha1.MockAPI_Condition_WasmCondition{Module: test.module},
+		}}
+		matched, err := plugin.Match(context.TODO(), request, condition)
+		if test.expectErr {
+			assert.NotNil(t, err, test.module)
+			continue
+		}
+		assert.Nil(t, err, test.module)
+		assert.Equal(t, test.expect, matched, test.module)
+	}
+
+	// conditions of other plugins are ignored
+	matched, err := plugin.Match(context.TODO(), request, &v1alpha1.MockAPI_Condition{})
+	assert.Nil(t, err)
+	assert.False(t, matched)
+}
+
+func TestMockResponse(t *testing.T) {
+	plugin := newTestPlugin(t, map[string]testModule{
+		"greeter": {mockOutput: `{"response": {"code": 201, "header": {"x-powered-by": "wasm"}, "body": {"message": "hello"}}}`},
+		"abort":   {mockOutput: `{"abort": true}`},
+		"error":   {mockOutput: `{"error": "failed"}`},
+		"loop":    {mockOutput: `{}`, loop: true},
+	}, nil)
+	tests := []struct {
+		module      string
+		timeout     time.Duration
+		expectAbort bool
+		expectCode  uint32
+		expectBody  string
+		expectErr   bool
+	}{
+		{module: "greeter", expectCode: 201, expectBody: `{"message": "hello"}`},
+		{module: "abort", expectAbort: true, expectCode: 1},
+		{module: "error", expectErr: true},
+		{module: "loop", timeout: 50 * time.Millisecond, expectErr: true},
+	}
+	request := &interact.Request{Protocol: interact.ProtocolHTTP, Method: "GET", Path: "/hello"}
+	for _, test := range tests {
+		mock := &v1alpha1.MockAPI_Response{Response: &v1alpha1.MockAPI_Response_Wasm{
+			Wasm: &v1alpha1.MockAPI_Response_WasmResponse{Module: test.module},
+		}}
+		if test.timeout > 0 {
+			mock.GetWasm().Timeout = durationpb.New(test.timeout)
+		}
+		response := interact.NewDefaultResponse(request)
+		start := time.Now()
+		abort, err := plugin.MockResponse(context.TODO(), mock, request, response)
+		if test.expectErr {
+			assert.NotNil(t, err, test.module)
+			assert.True(t, abort, test.module)
+			assert.Less(t, time.Since(start), time.Second, test.module)
+			continue
+		}
+		assert.Nil(t, err, test.module)
+		assert.Equal(t, test.expectAbort, abort, test.module)
+		assert.Equal(t, test.expectCode, response.Code, test.module)
+		if test.expectBody != "" {
+			assert.JSONEq(t, test.expectBody, string(response.Body.Bytes()), test.module)
+			assert.Equal(t, "wasm", response.Header.Get("x-powered-by"), test.module)
+		}
+	}
+}
+
+func TestGetModuleFromBlob(t *testing.T) {
+	blobs := map[string]*v1alpha1.Blob{
+		"blob": {Name: "blob", Data: testModule{matchOutput: `{"match": true}`}.build()},
+	}
+	plugin := newTestPlugin(t, nil, blobs)
+	first, err := plugin.getModule(context.TODO(), "blob")
+	assert.Nil(t, err)
+	cached, err := plugin.getModule(context.TODO(), "blob")
+	assert.Nil(t, err)
+	assert.Equal(t, first, cached)
+
+	// the module is recompiled if the blob is changed
+	blobs["blob"] = &v1alpha1.Blob{Name: "blob", Data: testModule{matchOutput: `{"match": false}`}.build()}
+	recompiled, err := plugin.getModule(context.TODO(), "blob")
+	assert.Nil(t, err)
+	assert.NotEqual(t, first, recompiled)
+	matched, err := plugin.Match(context.TODO(), &interact.Request{}, &v1alpha1.MockAPI_Condition{
+		Condition: &v1alpha1.MockAPI_Condition_Wasm{Wasm: &v1alpha1.MockAPI_Condition_WasmCondition{Module: "blob"}},
+	})
+	assert.Nil(t, err)
+	assert.False(t, matched)
+}
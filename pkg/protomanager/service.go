@@ -18,9 +18,11 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
 
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
 	"github.com/bilibili-base/powermock/pkg/protomanager/synchronization"
 	"github.com/bilibili-base/powermock/pkg/util"
 	"github.com/bilibili-base/powermock/pkg/util/logger"
@@ -39,6 +42,33 @@ type Provider interface {
 	Start(ctx context.Context, cancelFunc context.CancelFunc) error
 	// GetMethod is used to get descriptor of specified grpc path
 	GetMethod(name string) (*desc.MethodDescriptor, bool)
+	// GetMessage is used to get descriptor of the fully qualified message name
+	GetMessage(name string) (*desc.MessageDescriptor, bool)
+	// ListFiles is used to list all loaded proto files
+	ListFiles() []*FileInfo
+	// ListLoadErrors is used to list the errors encountered during the last load
+	ListLoadErrors() []*LoadError
+	// ParseProtoSources is used to parse the given proto sources without loading them
+	ParseProtoSources(sources []*v1alpha1.ProtoSource) ([]*desc.FileDescriptor, error)
+	// SetProtoSources is used to replace the uploaded proto sources and reload all proto files
+	SetProtoSources(sources []*v1alpha1.ProtoSource) error
+}
+
+// FileInfo defines a loaded proto file and where it comes from
+type FileInfo struct {
+	Descriptor *desc.FileDescriptor
+	// Path is the path of file relative to protoDir
+	Path string
+	// Repository is the address of git repository which the file belongs to
+	Repository string
+	// Uploaded is true if the file is uploaded at runtime
+	Uploaded bool
+}
+
+// LoadError defines the error encountered while loading proto files
+type LoadError struct {
+	Path  string
+	Error string
 }
 
 // Manager is the implement of Provider
@@ -46,7 +76,15 @@ type Manager struct {
 	cfg *Config
 
 	// map[name]*desc.MethodDescriptor
-	methods         *sync.Map
+	methods *sync.Map
+	// readonly
+	files []*FileInfo
+	// readonly
+	loadErrors []*LoadError
+	// proto sources uploaded at runtime
+	// readonly
+	sources []*v1alpha1.ProtoSource
+	// used to protect the pointer of methods, files, loadErrors, sources
 	methodsLock     sync.Mutex
 	synchronization *synchronization.Service
 
@@ -126,6 +164,33 @@ func (s *Manager) GetMethod(name string) (*desc.MethodDescriptor, bool) {
 	return val.(*desc.MethodDescriptor), true
 }
 
+// GetMessage is used to get descriptor of the fully qualified message name
+// Uploaded files are listed before the local ones, so their messages take precedence
+func (s *Manager) GetMessage(name string) (*desc.MessageDescriptor, bool) {
+	name = strings.TrimPrefix(name, ".")
+	for _, file := range s.ListFiles() {
+		if message := file.Descriptor.FindMessage(name); message != nil {
+			return message, true
+		}
+	}
+	return nil, false
+}
+
+// ListFiles is used to list all loaded proto files, the uploaded files are listed first,
+// and the local files replaced by them are excluded
+func (s *Manager) ListFiles() []*FileInfo {
+	s.methodsLock.Lock()
+	defer s.methodsLock.Unlock()
+	return s.files
+}
+
+// ListLoadErrors is used to list the errors encountered during the last load
+func (s *Manager) ListLoadErrors() []*LoadError {
+	s.methodsLock.Lock()
+	defer s.methodsLock.Unlock()
+	return s.loadErrors
+}
+
 func (s *Manager) Start(ctx context.Context, cancelFunc context.CancelFunc) error {
 	if err := s.startSynchronization(ctx, cancelFunc); err != nil {
 		return err
@@ -188,15 +253,16 @@ func (s *Manager) synchronizeProto(ctx context.Context, force bool) error {
 }
 
 func (s *Manager) loadProto() error {
-	var methods sync.Map
+	var localFiles []*FileInfo
+	var loadErrors []*LoadError
 	protoDir := s.cfg.ProtoDir
 	importPaths := append([]string{protoDir}, s.cfg.ProtoImportPaths...)
 	s.LogInfo(nil, "starting load proto from: %s", protoDir)
 
-	var count int
 	err := filepath.Walk(protoDir, func(path string, info fs.FileInfo, err error) error {
 		if err != nil {
 			s.LogWarn(nil, "load proto error: %s", err)
+			loadErrors = append(loadErrors, &LoadError{Path: path, Error: err.Error()})
 			// * skip fs error
 			return nil
 		}
@@ -216,29 +282,80 @@ func (s *Manager) loadProto() error {
 		fds, err := parser.ParseFiles(relPath)
 		if err != nil {
 			s.LogError(nil, "failed to parse file: %s", err)
+			loadErrors = append(loadErrors, &LoadError{Path: relPath, Error: err.Error()})
 			return nil
 		}
+		var repository string
+		if s.synchronization != nil {
+			repository, _ = s.synchronization.GetRepositoryByPath(path)
+		}
+		for _, fd := range fds {
+			localFiles = append(localFiles, &FileInfo{
+				Descriptor: fd,
+				Path:       relPath,
+				Repository: repository,
+			})
+		}
+		return nil
+	})
+
+	s.methodsLock.Lock()
+	sources := s.sources
+	s.methodsLock.Unlock()
+	// uploaded files take precedence over the local ones, so they are placed first
+	var files []*FileInfo
+	replaced := map[string]bool{}
+	if len(sources) > 0 {
+		fds, errs := s.parseProtoSources(sources)
+		loadErrors = append(loadErrors, errs...)
 		for _, fd := range fds {
+			files = append(files, &FileInfo{
+				Descriptor: fd,
+				Path:       fd.GetName(),
+				Uploaded:   true,
+			})
+			replaced[fd.GetName()] = true
 			for _, service := range fd.GetServices() {
-				for _, method := range service.GetMethods() {
-					name := GetPathByFullyQualifiedName(method.GetFullyQualifiedName())
-					s.LogInfo(map[string]interface{}{
-						"name": name,
-					}, "api loaded")
-					_, loaded := methods.LoadOrStore(name, method)
-					if loaded {
-						s.LogWarn(map[string]interface{}{
-							"name":  name,
-							"error": "method already exists",
-						}, "failed to load method")
-						continue
-					}
-					count++
+				replaced[service.GetFullyQualifiedName()] = true
+			}
+		}
+	}
+	for _, file := range localFiles {
+		if isReplaced(file.Descriptor, replaced) {
+			s.LogInfo(map[string]interface{}{
+				"file": file.Descriptor.GetName(),
+			}, "local file is replaced by the uploaded one")
+			continue
+		}
+		files = append(files, file)
+	}
+
+	var methods sync.Map
+	var count int
+	for _, file := range files {
+		for _, service := range file.Descriptor.GetServices() {
+			for _, method := range service.GetMethods() {
+				name := GetPathByFullyQualifiedName(method.GetFullyQualifiedName())
+				_, loaded := methods.LoadOrStore(name, method)
+				if loaded {
+					s.LogWarn(map[string]interface{}{
+						"name":  name,
+						"error": "method already exists",
+					}, "failed to load method")
+					loadErrors = append(loadErrors, &LoadError{
+						Path:  file.Path,
+						Error: fmt.Sprintf("method %s already exists", name),
+					})
+					continue
 				}
+				s.LogInfo(map[string]interface{}{
+					"name":     name,
+					"uploaded": file.Uploaded,
+				}, "api loaded")
+				count++
 			}
 		}
-		return nil
-	})
+	}
 
 	s.LogInfo(map[string]interface{}{
 		"total":    count,
@@ -247,6 +364,8 @@ func (s *Manager) loadProto() error {
 
 	s.methodsLock.Lock()
 	s.methods = &methods
+	s.files = files
+	s.loadErrors = loadErrors
 	s.methodsLock.Unlock()
 
 	if err != nil {
@@ -255,6 +374,20 @@ func (s *Manager) loadProto() error {
 	return nil
 }
 
+// isReplaced is used to determine whether the local file is replaced by an uploaded file,
+// which has the same name or defines any of its services
+func isReplaced(fd *desc.FileDescriptor, replaced map[string]bool) bool {
+	if replaced[fd.GetName()] {
+		return true
+	}
+	for _, service := range fd.GetServices() {
+		if replaced[service.GetFullyQualifiedName()] {
+			return true
+		}
+	}
+	return false
+}
+
 // GetPathByFullyQualifiedName is used to get the grpc path of specified fully qualified name
 func GetPathByFullyQualifiedName(name string) string {
 	raw := []byte(name)
@@ -0,0 +1,92 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func newTestManager(t *testing.T, files map[string]string) *Manager {
+	dir := t.TempDir()
+	for name, content := range files {
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	cfg := NewConfig()
+	cfg.ProtoDir = dir
+	cfg.ProtoImportPaths = nil
+	manager, err := New(cfg, logger.NewDefault("test"), prometheus.NewRegistry())
+	assert.Nil(t, err)
+	return manager
+}
+
+func TestUploadedFilesTakePrecedence(t *testing.T) {
+	manager := newTestManager(t, map[string]string{
+		"test.proto":  `syntax = "proto3"; package test; service Greeter { rpc Hello(Request) returns (Request); } message Request { string name = 1; }`,
+		"other.proto": `syntax = "proto3"; package other; service Other { rpc Get(Reply) returns (Reply); } message Reply {}`,
+	})
+	uploaded := `syntax = "proto3"; package test; service Greeter { rpc Hello(Request) returns (Request); } message Request { string name = 1; int32 age = 2; }`
+	tests := []struct {
+		name    string
+		sources []*v1alpha1.ProtoSource
+		files   []string
+		age     bool
+	}{
+		{name: "local", files: []string{"other.proto", "test.proto"}},
+		{
+			name:    "same name",
+			sources: []*v1alpha1.ProtoSource{{Name: "test.proto", Content: uploaded}},
+			files:   []string{"test.proto(uploaded)", "other.proto"},
+			age:     true,
+		},
+		{
+			name:    "same service",
+			sources: []*v1alpha1.ProtoSource{{Name: "uploaded/test.proto", Content: uploaded}},
+			files:   []string{"uploaded/test.proto(uploaded)", "other.proto"},
+			age:     true,
+		},
+	}
+	for _, test := range tests {
+		assert.Nil(t, manager.SetProtoSources(test.sources), test.name)
+		var files []string
+		for _, file := range manager.ListFiles() {
+			if file.Uploaded {
+				files = append(files, file.Path+"(uploaded)")
+				continue
+			}
+			files = append(files, file.Path)
+		}
+		assert.Equal(t, test.files, files, test.name)
+		assert.Empty(t, manager.ListLoadErrors(), test.name)
+
+		message, ok := manager.GetMessage(".test.Request")
+		if assert.True(t, ok, test.name) {
+			assert.Equal(t, test.age, message.FindFieldByName("age") != nil, test.name)
+		}
+		method, ok := manager.GetMethod("/test.Greeter/Hello")
+		if assert.True(t, ok, test.name) {
+			assert.Same(t, message, method.GetInputType(), test.name)
+		}
+		_, ok = manager.GetMethod("/other.Other/Get")
+		assert.True(t, ok, test.name)
+	}
+}
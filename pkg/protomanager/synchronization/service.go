@@ -162,6 +162,26 @@ func (s *Service) SynchronizeRepository(ctx context.Context, repository string,
 	return true, nil
 }
 
+// GetRepositoryByPath is used to get the address of repository which contains the specified path
+func (s *Service) GetRepositoryByPath(path string) (string, bool) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+	for _, repo := range s.cfg.Repository {
+		location, err := filepath.Abs(filepath.Join(s.cfg.StorageDir, getRepositoryPathFromUrl(repo.Address)))
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(location, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return repo.Address, true
+	}
+	return "", false
+}
+
 // Start is used to start the service
 func (s *Service) Start(ctx context.Context, cancelFunc context.CancelFunc) error {
 	return nil
@@ -0,0 +1,177 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomanager
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/hashicorp/go-multierror"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+)
+
+// ParseProtoSources is used to parse the given proto sources without loading them
+func (s *Manager) ParseProtoSources(sources []*v1alpha1.ProtoSource) ([]*desc.FileDescriptor, error) {
+	fds, loadErrors := s.parseProtoSources(sources)
+	var result error
+	for _, loadError := range loadErrors {
+		result = multierror.Append(result, fmt.Errorf("%s: %s", loadError.Path, loadError.Error))
+	}
+	if result != nil {
+		return nil, result
+	}
+	return fds, nil
+}
+
+// SetProtoSources is used to replace the uploaded proto sources and reload all proto files
+func (s *Manager) SetProtoSources(sources []*v1alpha1.ProtoSource) error {
+	s.methodsLock.Lock()
+	s.sources = sources
+	s.methodsLock.Unlock()
+	return s.loadProto()
+}
+
+// parseProtoSources is used to parse each of the given sources,
+// uploaded sources can import each other as well as files in protoDir and import paths
+func (s *Manager) parseProtoSources(sources []*v1alpha1.ProtoSource) ([]*desc.FileDescriptor, []*LoadError) {
+	var loadErrors []*LoadError
+	contents := map[string][]byte{}
+	descriptors := map[string]*descriptor.FileDescriptorProto{}
+	for _, source := range sources {
+		if source.GetName() == "" {
+			loadErrors = append(loadErrors, &LoadError{Error: "name of proto source is required"})
+			continue
+		}
+		if len(source.GetDescriptor_()) == 0 {
+			contents[source.GetName()] = []byte(source.GetContent())
+			continue
+		}
+		var fd descriptor.FileDescriptorProto
+		if err := proto.Unmarshal(source.GetDescriptor_(), &fd); err != nil {
+			loadErrors = append(loadErrors, &LoadError{Path: source.GetName(), Error: err.Error()})
+			continue
+		}
+		descriptors[source.GetName()] = &fd
+	}
+
+	importPaths := append([]string{s.cfg.ProtoDir}, s.cfg.ProtoImportPaths...)
+	parser := protoparse.Parser{
+		Accessor: func(filename string) (io.ReadCloser, error) {
+			if content, ok := contents[filename]; ok {
+				return ioutil.NopCloser(bytes.NewReader(content)), nil
+			}
+			for _, importPath := range importPaths {
+				if f, err := os.Open(filepath.Join(importPath, filename)); err == nil {
+					return f, nil
+				}
+			}
+			return nil, os.ErrNotExist
+		},
+		LookupImportProto: func(filename string) (*descriptor.FileDescriptorProto, error) {
+			if fd, ok := descriptors[filename]; ok {
+				return fd, nil
+			}
+			return nil, os.ErrNotExist
+		},
+		IncludeSourceCodeInfo: true,
+	}
+	// dependencies of uploaded descriptors which are not uploaded are parsed from protoDir and import paths,
+	// and fall back to the registered files
+	resolve := func(name string) (*desc.FileDescriptor, error) {
+		parsed, err := parser.ParseFiles(name)
+		if err == nil {
+			return parsed[0], nil
+		}
+		if fd, loadErr := desc.LoadFileDescriptor(name); loadErr == nil {
+			return fd, nil
+		}
+		return nil, err
+	}
+	var fds []*desc.FileDescriptor
+	built := map[string]*desc.FileDescriptor{}
+	for _, source := range sources {
+		name := source.GetName()
+		if _, ok := descriptors[name]; !ok {
+			continue
+		}
+		fd, err := buildFileDescriptor(name, descriptors, built, map[string]bool{}, resolve)
+		if err != nil {
+			loadErrors = append(loadErrors, &LoadError{Path: name, Error: err.Error()})
+			continue
+		}
+		fds = append(fds, fd)
+	}
+
+	for _, source := range sources {
+		name := source.GetName()
+		if _, ok := contents[name]; !ok {
+			continue
+		}
+		parsed, err := parser.ParseFiles(name)
+		if err != nil {
+			loadErrors = append(loadErrors, &LoadError{Path: name, Error: err.Error()})
+			continue
+		}
+		fds = append(fds, parsed...)
+	}
+	return fds, loadErrors
+}
+
+// buildFileDescriptor is used to build the file descriptor and its dependencies from uploaded descriptors,
+// dependencies which are not uploaded are resolved by resolve, such as files in protoDir and well-known types
+func buildFileDescriptor(name string, descriptors map[string]*descriptor.FileDescriptorProto,
+	built map[string]*desc.FileDescriptor, visiting map[string]bool,
+	resolve func(name string) (*desc.FileDescriptor, error)) (*desc.FileDescriptor, error) {
+	if fd, ok := built[name]; ok {
+		return fd, nil
+	}
+	fdp, ok := descriptors[name]
+	if !ok {
+		fd, err := resolve(name)
+		if err != nil {
+			return nil, err
+		}
+		built[name] = fd
+		return fd, nil
+	}
+	if visiting[name] {
+		return nil, errors.New("import cycle detected")
+	}
+	visiting[name] = true
+	var deps []*desc.FileDescriptor
+	for _, dependency := range fdp.GetDependency() {
+		dep, err := buildFileDescriptor(dependency, descriptors, built, visiting, resolve)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve dependency %s: %s", dependency, err)
+		}
+		deps = append(deps, dep)
+	}
+	fd, err := desc.CreateFileDescriptor(fdp, deps...)
+	if err != nil {
+		return nil, err
+	}
+	built[name] = fd
+	return fd, nil
+}
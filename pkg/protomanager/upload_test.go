@@ -0,0 +1,57 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomanager
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/apis/v1alpha1"
+)
+
+func TestParseProtoSourcesWithDescriptor(t *testing.T) {
+	types := `syntax = "proto3"; package common; message Item { string name = 1; }`
+	manager := newTestManager(t, map[string]string{"types.proto": types})
+	parser := protoparse.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"types.proto": types,
+			"api.proto": `syntax = "proto3"; package api; import "types.proto"; import "google/protobuf/empty.proto";
+service API { rpc Get(google.protobuf.Empty) returns (common.Item); }`,
+			"missing.proto": `syntax = "proto3"; package missing; import "types.proto"; message Missing { common.Item item = 1; }`,
+		}),
+	}
+	fds, err := parser.ParseFiles("api.proto", "missing.proto")
+	assert.Nil(t, err)
+	api, err := proto.Marshal(fds[0].AsFileDescriptorProto())
+	assert.Nil(t, err)
+	missing, err := proto.Marshal(fds[1].AsFileDescriptorProto())
+	assert.Nil(t, err)
+
+	parsed, err := manager.ParseProtoSources([]*v1alpha1.ProtoSource{{Name: "api.proto", Descriptor_: api}})
+	assert.Nil(t, err)
+	if assert.Len(t, parsed, 1) {
+		method := parsed[0].FindService("api.API").FindMethodByName("Get")
+		assert.Equal(t, "google.protobuf.Empty", method.GetInputType().GetFullyQualifiedName())
+		assert.Equal(t, "common.Item", method.GetOutputType().GetFullyQualifiedName())
+		assert.NotNil(t, method.GetOutputType().FindFieldByName("name"))
+	}
+
+	// the dependency is neither uploaded nor in protoDir
+	_, err = newTestManager(t, nil).ParseProtoSources([]*v1alpha1.ProtoSource{{Name: "missing.proto", Descriptor_: missing}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,239 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"bytes"
+	"container/list"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxLeafs is the max number of leaf certificates cached by an authority
+const maxLeafs = 1024
+
+var (
+	// authorities are shared by the managers with the same CA files in the process,
+	// map[certFile+"\x00"+keyFile]*authority
+	authorities     = map[string]*authority{}
+	authoritiesLock sync.Mutex
+)
+
+// authority is the self-signed CA used to issue leaf certificates
+type authority struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+
+	// leafs is the LRU cache of issued certificates, the elements are *leafEntry
+	leafs    map[string]*list.Element
+	leafList *list.List
+	// used to protect leafs and leafList
+	lock sync.Mutex
+}
+
+type leafEntry struct {
+	serverName  string
+	certificate *tls.Certificate
+}
+
+// getAuthority is used to return the CA shared by the managers with the same CA files,
+// it is loaded or created on first use
+func getAuthority(certFile, keyFile string) (*authority, error) {
+	authoritiesLock.Lock()
+	defer authoritiesLock.Unlock()
+	key := certFile + "\x00" + keyFile
+	if ca, ok := authorities[key]; ok {
+		return ca, nil
+	}
+	ca, err := loadOrCreateAuthority(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	authorities[key] = ca
+	return ca, nil
+}
+
+// CACertificates is used to return the PEM encoded certificates of the self-signed CAs in use,
+// clients should trust them to verify the auto-generated certificates
+func CACertificates() []byte {
+	authoritiesLock.Lock()
+	defer authoritiesLock.Unlock()
+	keys := make([]string, 0, len(authorities))
+	for key := range authorities {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for _, key := range keys {
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: authorities[key].cert.Raw})
+	}
+	return buf.Bytes()
+}
+
+// loadOrCreateAuthority is used to load the CA from files,
+// it will be generated (and written to files if they are specified) if it does not exist
+func loadOrCreateAuthority(certFile, keyFile string) (*authority, error) {
+	if certFile != "" {
+		if _, err := os.Stat(certFile); err == nil {
+			pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return nil, err
+			}
+			cert, err := x509.ParseCertificate(pair.Certificate[0])
+			if err != nil {
+				return nil, err
+			}
+			key, ok := pair.PrivateKey.(crypto.Signer)
+			if !ok {
+				return nil, errors.New("unsupported private key of CA")
+			}
+			return newAuthority(cert, key), nil
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          newSerialNumber(),
+		Subject:               pkix.Name{CommonName: "PowerMock CA", Organization: []string{"PowerMock"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	if certFile != "" {
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+			return nil, err
+		}
+	}
+	return newAuthority(cert, key), nil
+}
+
+func newAuthority(cert *x509.Certificate, key crypto.Signer) *authority {
+	return &authority{
+		cert:     cert,
+		key:      key,
+		leafs:    map[string]*list.Element{},
+		leafList: list.New(),
+	}
+}
+
+// getCertificate is used to return the cached certificate of serverName or issue a new one,
+// the least recently used certificate is evicted if there are more than maxLeafs certificates
+func (a *authority) getCertificate(serverName string) (*tls.Certificate, bool, error) {
+	a.lock.Lock()
+	if element, ok := a.leafs[serverName]; ok {
+		entry := element.Value.(*leafEntry)
+		if time.Now().Before(entry.certificate.Leaf.NotAfter) {
+			a.leafList.MoveToFront(element)
+			a.lock.Unlock()
+			return entry.certificate, false, nil
+		}
+	}
+	a.lock.Unlock()
+
+	// the key is generated without holding the lock
+	certificate, err := a.issue(serverName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if element, ok := a.leafs[serverName]; ok {
+		a.leafList.Remove(element)
+	}
+	a.leafs[serverName] = a.leafList.PushFront(&leafEntry{serverName: serverName, certificate: certificate})
+	for a.leafList.Len() > maxLeafs {
+		oldest := a.leafList.Back()
+		a.leafList.Remove(oldest)
+		delete(a.leafs, oldest.Value.(*leafEntry).serverName)
+	}
+	return certificate, true, nil
+}
+
+// issue is used to issue a leaf certificate for the given host
+func (a *authority) issue(host string) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject:      pkix.Name{CommonName: host, Organization: []string{"PowerMock"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		template.IPAddresses = []net.IP{ip}
+	} else {
+		template.DNSNames = []string{host}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, key.Public(), a.key)
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{der, a.cert.Raw},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+func newSerialNumber() *big.Int {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return big.NewInt(time.Now().UnixNano())
+	}
+	return serialNumber
+}
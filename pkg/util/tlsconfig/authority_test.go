@@ -0,0 +1,76 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func TestSharedAuthority(t *testing.T) {
+	log := logger.NewDefault("test")
+	dir := t.TempDir()
+	cfg := &Config{
+		Enable:       true,
+		AutoGenerate: true,
+		CACertFile:   filepath.Join(dir, "ca.pem"),
+		CAKeyFile:    filepath.Join(dir, "ca.key"),
+	}
+	a, err := New(cfg, log)
+	assert.Nil(t, err)
+	b, err := New(cfg, log)
+	assert.Nil(t, err)
+	assert.Same(t, a.ca, b.ca)
+
+	block, _ := pem.Decode(CACertificates())
+	assert.NotNil(t, block)
+	pool := x509.NewCertPool()
+	pool.AddCert(a.ca.cert)
+	for _, serverName := range []string{"example.com", "127.0.0.1"} {
+		certificate, err := b.getCertificate(&tls.ClientHelloInfo{ServerName: serverName})
+		assert.Nil(t, err)
+		_, err = certificate.Leaf.Verify(x509.VerifyOptions{DNSName: serverName, Roots: pool})
+		assert.Nil(t, err, serverName)
+		cached, err := a.getCertificate(&tls.ClientHelloInfo{ServerName: serverName})
+		assert.Nil(t, err)
+		assert.Same(t, certificate, cached)
+	}
+}
+
+func TestAuthorityLeafsEviction(t *testing.T) {
+	ca, err := loadOrCreateAuthority("", "")
+	assert.Nil(t, err)
+	first, issued, err := ca.getCertificate("host-0")
+	assert.Nil(t, err)
+	assert.True(t, issued)
+	for i := 1; i <= maxLeafs; i++ {
+		_, _, err := ca.getCertificate(fmt.Sprintf("host-%d", i))
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, maxLeafs, len(ca.leafs))
+	assert.Equal(t, maxLeafs, ca.leafList.Len())
+	again, issued, err := ca.getCertificate("host-0")
+	assert.Nil(t, err)
+	assert.True(t, issued)
+	assert.NotSame(t, first, again)
+}
@@ -0,0 +1,268 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+// defines the supported client auth types
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"none":             tls.NoClientCert,
+	"request":          tls.RequestClientCert,
+	"require":          tls.RequireAnyClientCert,
+	"verifyIfGiven":    tls.VerifyClientCertIfGiven,
+	"requireAndVerify": tls.RequireAndVerifyClientCert,
+}
+
+// Config defines the config structure
+type Config struct {
+	Enable   bool
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is used to verify client certificates
+	ClientCAFile string
+	// ClientAuth is one of none, request, require, verifyIfGiven and requireAndVerify,
+	// it defaults to requireAndVerify if ClientCAFile is specified, otherwise none
+	ClientAuth string
+	// ReloadInterval is the interval to check whether the files above are changed
+	ReloadInterval time.Duration
+	// AutoGenerate is used to generate leaf certificates for any SNI host signed by a self-signed CA,
+	// it is used when CertFile and KeyFile are empty
+	AutoGenerate bool
+	// CACertFile and CAKeyFile define where the self-signed CA is loaded from,
+	// the CA will be generated and written to them if they do not exist,
+	// and it is kept in memory only if they are empty, which is served by the apiManager at /tls/ca.pem.
+	// The servers with the same CA files share the same CA
+	CACertFile string
+	CAKeyFile  string
+}
+
+// NewConfig is used to init config with default values
+func NewConfig() *Config {
+	return &Config{
+		Enable:         false,
+		ReloadInterval: 10 * time.Second,
+	}
+}
+
+// IsEnabled is used to return whether the current component is enabled
+func (c *Config) IsEnabled() bool {
+	return c != nil && c.Enable
+}
+
+// RegisterFlagsWithPrefix is used to register flags
+func (c *Config) RegisterFlagsWithPrefix(prefix string, f *pflag.FlagSet) {
+	f.BoolVar(&c.Enable, prefix+"tls.enable", c.Enable, "define whether to enable TLS")
+	f.StringVar(&c.CertFile, prefix+"tls.certFile", c.CertFile, "certificate file")
+	f.StringVar(&c.KeyFile, prefix+"tls.keyFile", c.KeyFile, "private key file")
+	f.StringVar(&c.ClientCAFile, prefix+"tls.clientCAFile", c.ClientCAFile, "CA file to verify client certificates")
+	f.StringVar(&c.ClientAuth, prefix+"tls.clientAuth", c.ClientAuth, "client auth type(none, request, require, verifyIfGiven, requireAndVerify)")
+	f.DurationVar(&c.ReloadInterval, prefix+"tls.reloadInterval", c.ReloadInterval, "interval to check whether certificates are changed")
+	f.BoolVar(&c.AutoGenerate, prefix+"tls.autoGenerate", c.AutoGenerate, "generate certificates for any SNI host with a self-signed CA")
+	f.StringVar(&c.CACertFile, prefix+"tls.caCertFile", c.CACertFile, "certificate file of the self-signed CA")
+	f.StringVar(&c.CAKeyFile, prefix+"tls.caKeyFile", c.CAKeyFile, "private key file of the self-signed CA")
+}
+
+// Validate is used to validate config and returns error on failure
+func (c *Config) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("certFile and keyFile must be specified together")
+	}
+	if c.CertFile == "" && !c.AutoGenerate {
+		return errors.New("certFile and keyFile are required unless autoGenerate is enabled")
+	}
+	if (c.CACertFile == "") != (c.CAKeyFile == "") {
+		return errors.New("caCertFile and caKeyFile must be specified together")
+	}
+	if _, ok := clientAuthTypes[c.ClientAuth]; c.ClientAuth != "" && !ok {
+		return fmt.Errorf("unknown clientAuth: %s", c.ClientAuth)
+	}
+	return nil
+}
+
+// Manager is used to provide tls.Config which reloads certificates from disk
+// and generates certificates for SNI hosts on demand
+type Manager struct {
+	cfg *Config
+
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+	modTimes    map[string]time.Time
+	lastChecked time.Time
+	// ca is shared by the managers with the same CA files
+	ca *authority
+	// used to protect all the fields above
+	lock sync.Mutex
+
+	logger.Logger
+}
+
+// New is used to init service
+func New(cfg *Config, logger logger.Logger) (*Manager, error) {
+	m := &Manager{
+		cfg:      cfg,
+		modTimes: map[string]time.Time{},
+		Logger:   logger.NewLogger("tls"),
+	}
+	if cfg.CertFile == "" && cfg.AutoGenerate {
+		ca, err := getAuthority(cfg.CACertFile, cfg.CAKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		m.ca = ca
+	}
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TLSConfig is used to return the server side tls.Config with the given application protocols
+func (m *Manager) TLSConfig(nextProtos ...string) *tls.Config {
+	clientAuth, ok := clientAuthTypes[m.cfg.ClientAuth]
+	if !ok && m.cfg.ClientCAFile != "" {
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+	config := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		NextProtos:     nextProtos,
+		ClientAuth:     clientAuth,
+		GetCertificate: m.getCertificate,
+	}
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		m.reloadIfChanged()
+		m.lock.Lock()
+		clientCAs := m.clientCAs
+		m.lock.Unlock()
+		c := config.Clone()
+		c.ClientCAs = clientCAs
+		c.GetConfigForClient = nil
+		return c, nil
+	}
+	return config
+}
+
+// getCertificate is used to return the loaded certificate or generate one for the SNI host
+func (m *Manager) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	m.lock.Lock()
+	ca, certificate := m.ca, m.certificate
+	m.lock.Unlock()
+	if ca == nil {
+		return certificate, nil
+	}
+	serverName := hello.ServerName
+	if serverName == "" && hello.Conn != nil {
+		if host, _, err := net.SplitHostPort(hello.Conn.LocalAddr().String()); err == nil {
+			serverName = host
+		}
+	}
+	if serverName == "" {
+		serverName = "localhost"
+	}
+	leaf, issued, err := ca.getCertificate(serverName)
+	if err != nil {
+		return nil, err
+	}
+	if issued {
+		m.LogInfo(map[string]interface{}{
+			"serverName": serverName,
+		}, "certificate generated")
+	}
+	return leaf, nil
+}
+
+// reloadIfChanged is used to reload files if they are changed since last loading
+func (m *Manager) reloadIfChanged() {
+	m.lock.Lock()
+	if time.Since(m.lastChecked) < m.cfg.ReloadInterval {
+		m.lock.Unlock()
+		return
+	}
+	m.lastChecked = time.Now()
+	changed := false
+	for file, modTime := range m.modTimes {
+		info, err := os.Stat(file)
+		if err == nil && !info.ModTime().Equal(modTime) {
+			changed = true
+			break
+		}
+	}
+	m.lock.Unlock()
+	if !changed {
+		return
+	}
+	if err := m.load(); err != nil {
+		m.LogWarn(nil, "failed to reload certificates, keep using the previous ones: %s", err)
+		return
+	}
+	m.LogInfo(nil, "certificates reloaded")
+}
+
+// load is used to load certificate and client CA files
+func (m *Manager) load() error {
+	modTimes := map[string]time.Time{}
+	for _, file := range []string{m.cfg.CertFile, m.cfg.KeyFile, m.cfg.ClientCAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes[file] = info.ModTime()
+	}
+	var certificate *tls.Certificate
+	if m.cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(m.cfg.CertFile, m.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load certificate: %s", err)
+		}
+		certificate = &cert
+	}
+	var clientCAs *x509.CertPool
+	if m.cfg.ClientCAFile != "" {
+		data, err := ioutil.ReadFile(m.cfg.ClientCAFile)
+		if err != nil {
+			return err
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificate found in %s", m.cfg.ClientCAFile)
+		}
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.certificate = certificate
+	m.clientCAs = clientCAs
+	m.modTimes = modTimes
+	m.lastChecked = time.Now()
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 bilibili-base
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bilibili-base/powermock/pkg/util/logger"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   *Config
+		valid bool
+	}{
+		{name: "disabled", cfg: &Config{}, valid: true},
+		{name: "nil", cfg: nil, valid: true},
+		{name: "cert files", cfg: &Config{Enable: true, CertFile: "a.pem", KeyFile: "a.key"}, valid: true},
+		{name: "cert without key", cfg: &Config{Enable: true, CertFile: "a.pem"}, valid: false},
+		{name: "no cert", cfg: &Config{Enable: true}, valid: false},
+		{name: "auto generate", cfg: &Config{Enable: true, AutoGenerate: true}, valid: true},
+		{name: "ca cert without key", cfg: &Config{Enable: true, AutoGenerate: true, CACertFile: "ca.pem"}, valid: false},
+		{name: "client auth", cfg: &Config{Enable: true, AutoGenerate: true, ClientAuth: "verifyIfGiven"}, valid: true},
+		{name: "unknown client auth", cfg: &Config{Enable: true, AutoGenerate: true, ClientAuth: "always"}, valid: false},
+	}
+	for _, test := range tests {
+		err := test.cfg.Validate()
+		assert.Equal(t, test.valid, err == nil, test.name)
+	}
+}
+
+// writeCertificate is used to issue a certificate signed by ca for the usage and write it to dir
+func writeCertificate(t *testing.T, dir string, name string, ca *authority, usage x509.ExtKeyUsage) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	assert.Nil(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	certFile, keyFile := filepath.Join(dir, name+".pem"), filepath.Join(dir, name+".key")
+	assert.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644))
+	assert.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+// handshake is used to perform a TLS handshake with the server config, and returns the server certificate
+func handshake(serverConfig *tls.Config, clientConfig *tls.Config) (*x509.Certificate, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte{0})
+	}()
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	// client certificates are verified after the handshake of client in TLS 1.3
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		return nil, err
+	}
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := loadOrCreateAuthority(filepath.Join(dir, "ca.pem"), filepath.Join(dir, "ca.key"))
+	assert.Nil(t, err)
+	certFile, keyFile := writeCertificate(t, dir, "server.test", ca, x509.ExtKeyUsageServerAuth)
+	clientCertFile, clientKeyFile := writeCertificate(t, dir, "client.test", ca, x509.ExtKeyUsageClientAuth)
+	clientCert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+	assert.Nil(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	tests := []struct {
+		name          string
+		cfg           *Config
+		serverName    string
+		withClientCrt bool
+		success       bool
+	}{
+		{
+			name:       "cert files",
+			cfg:        &Config{Enable: true, CertFile: certFile, KeyFile: keyFile},
+			serverName: "server.test",
+			success:    true,
+		},
+		{
+			name:       "auto generate",
+			cfg:        &Config{Enable: true, AutoGenerate: true, CACertFile: filepath.Join(dir, "ca.pem"), CAKeyFile: filepath.Join(dir, "ca.key")},
+			serverName: "any.test",
+			success:    true,
+		},
+		{
+			name:       "mutual TLS without client certificate",
+			cfg:        &Config{Enable: true, CertFile: certFile, KeyFile: keyFile, ClientCAFile: filepath.Join(dir, "ca.pem")},
+			serverName: "server.test",
+			success:    false,
+		},
+		{
+			name:          "mutual TLS",
+			cfg:           &Config{Enable: true, CertFile: certFile, KeyFile: keyFile, ClientCAFile: filepath.Join(dir, "ca.pem")},
+			serverName:    "server.test",
+			withClientCrt: true,
+			success:       true,
+		},
+		{
+			name:       "verify client certificate if given",
+			cfg:        &Config{Enable: true, CertFile: certFile, KeyFile: keyFile, ClientCAFile: filepath.Join(dir, "ca.pem"), ClientAuth: "verifyIfGiven"},
+			serverName: "server.test",
+			success:    true,
+		},
+	}
+	for _, test := range tests {
+		manager, err := New(test.cfg, logger.NewDefault("test"))
+		assert.Nil(t, err, test.name)
+		clientConfig := &tls.Config{ServerName: test.serverName, RootCAs: roots}
+		if test.withClientCrt {
+			clientConfig.Certificates = []tls.Certificate{clientCert}
+		}
+		cert, err := handshake(manager.TLSConfig("h2"), clientConfig)
+		assert.Equal(t, test.success, err == nil, test.name)
+		if err == nil {
+			assert.Equal(t, []string{test.serverName}, cert.DNSNames, test.name)
+		}
+	}
+
+	_, err = New(&Config{Enable: true, CertFile: filepath.Join(dir, "unknown.pem"), KeyFile: keyFile}, logger.NewDefault("test"))
+	assert.NotNil(t, err)
+}
+
+func TestTLSConfigReload(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := loadOrCreateAuthority("", "")
+	assert.Nil(t, err)
+	certFile, keyFile := writeCertificate(t, dir, "server.test", ca, x509.ExtKeyUsageServerAuth)
+	manager, err := New(&Config{Enable: true, CertFile: certFile, KeyFile: keyFile, ReloadInterval: time.Hour}, logger.NewDefault("test"))
+	assert.Nil(t, err)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientConfig := &tls.Config{ServerName: "server.test", RootCAs: roots}
+	first, err := handshake(manager.TLSConfig(), clientConfig)
+	assert.Nil(t, err)
+
+	// the certificate is reloaded after it is changed and reloadInterval is passed
+	time.Sleep(10 * time.Millisecond)
+	writeCertificate(t, dir, "server.test", ca, x509.ExtKeyUsageServerAuth)
+	second, err := handshake(manager.TLSConfig(), clientConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, first.SerialNumber, second.SerialNumber)
+
+	manager.cfg.ReloadInterval = 0
+	third, err := handshake(manager.TLSConfig(), clientConfig)
+	assert.Nil(t, err)
+	assert.NotEqual(t, first.SerialNumber, third.SerialNumber)
+}